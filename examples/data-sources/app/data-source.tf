@@ -1,4 +0,0 @@
-# Get an app.
-data "nuon_app" "my_app" {
-  id = "app123"
-}
@@ -0,0 +1,9 @@
+# Get an app.
+data "nuon_app" "my_app" {
+  id = "app123"
+}
+
+# Get an app by name.
+data "nuon_app" "by_name" {
+  name = "my_app"
+}
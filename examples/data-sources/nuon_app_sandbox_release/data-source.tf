@@ -0,0 +1,14 @@
+# Get the policy urls of an app's current sandbox.
+data "nuon_app_sandbox_release" "sandbox" {
+  app_id = "app123"
+}
+
+data "http" "trust_policy" {
+  url = data.nuon_app_sandbox_release.sandbox.trust_policy_url
+}
+
+# Create the install role before creating the install.
+resource "aws_iam_role" "install" {
+  name               = "nuon-install-access"
+  assume_role_policy = data.http.trust_policy.response_body
+}
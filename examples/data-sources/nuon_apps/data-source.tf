@@ -0,0 +1,7 @@
+# Get all apps in the org.
+data "nuon_apps" "all" {}
+
+# Get all apps whose name starts with "platform-".
+data "nuon_apps" "platform" {
+  name_prefix = "platform-"
+}
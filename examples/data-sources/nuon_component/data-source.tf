@@ -0,0 +1,10 @@
+# Get a component by ID.
+data "nuon_component" "by_id" {
+  id = "cmp123"
+}
+
+# Get a component by app ID and name.
+data "nuon_component" "by_name" {
+  app_id = "app123"
+  name   = "my_component"
+}
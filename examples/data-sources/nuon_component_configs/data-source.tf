@@ -0,0 +1,9 @@
+# Get the config history of a component.
+data "nuon_component_configs" "history" {
+  component_id = "cmp123"
+}
+
+# Decode the payload of the latest config.
+output "latest_config" {
+  value = jsondecode(data.nuon_component_configs.history.configs[length(data.nuon_component_configs.history.configs) - 1].config)
+}
@@ -0,0 +1,4 @@
+# Get all components of an app.
+data "nuon_components" "all" {
+  app_id = "app123"
+}
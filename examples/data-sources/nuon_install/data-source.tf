@@ -0,0 +1,10 @@
+# Get an install by ID.
+data "nuon_install" "by_id" {
+  id = "inl123"
+}
+
+# Get an install by app ID and name.
+data "nuon_install" "by_name" {
+  app_id = "app123"
+  name   = "customer-a"
+}
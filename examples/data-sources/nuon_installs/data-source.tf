@@ -0,0 +1,14 @@
+# Get all AWS installs of an app.
+data "nuon_installs" "aws" {
+  app_id = "app123"
+  cloud  = "aws"
+}
+
+# Get all installs of every app whose name starts with "customer-".
+data "nuon_installs" "customers" {
+  name_prefix = "customer-"
+}
+
+output "install_ids" {
+  value = { for install in data.nuon_installs.customers.installs : install.name => install.id }
+}
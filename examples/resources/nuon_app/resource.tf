@@ -1,3 +1,9 @@
 resource "nuon_app" "my_app" {
   name = "my_app"
 }
+
+# An app that can not be destroyed until deletion_protection is turned off.
+resource "nuon_app" "production" {
+  name                = "production"
+  deletion_protection = true
+}
@@ -0,0 +1,5 @@
+# Components can be imported by their IDs.
+terraform import nuon_container_image_component.my_component cmp123
+
+# Components can also be imported by app name (or ID) and component name.
+terraform import nuon_container_image_component.my_component my_app/my_component
@@ -0,0 +1,5 @@
+# Installs can be imported by their IDs.
+terraform import nuon_install.my_install inl123
+
+# Installs can also be imported by app name (or ID) and install name.
+terraform import nuon_install.my_install my_app/my_install
@@ -3,10 +3,15 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nuonco/nuon-go/models"
 )
 
 var _ datasource.DataSource = &AppDataSource{}
@@ -20,10 +25,25 @@ type AppDataSource struct {
 	baseDataSource
 }
 
+// AppDataSourceSandbox describes the current sandbox config of an app.
+type AppDataSourceSandbox struct {
+	ConfigID         types.String `tfsdk:"config_id"`
+	TerraformVersion types.String `tfsdk:"terraform_version"`
+}
+
 // AppDataSourceModel describes the data source data model.
 type AppDataSourceModel struct {
 	Name types.String `tfsdk:"name"`
 	Id   types.String `tfsdk:"id"`
+
+	// computed
+	Description       types.String          `tfsdk:"description"`
+	DisplayName       types.String          `tfsdk:"display_name"`
+	Status            types.String          `tfsdk:"status"`
+	StatusDescription types.String          `tfsdk:"status_description"`
+	Sandbox           *AppDataSourceSandbox `tfsdk:"sandbox"`
+	ComponentIDs      types.List            `tfsdk:"component_ids"`
+	InstallIDs        types.List            `tfsdk:"install_ids"`
 }
 
 func (d *AppDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -32,15 +52,60 @@ func (d *AppDataSource) Metadata(ctx context.Context, req datasource.MetadataReq
 
 func (d *AppDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Provides information about a Nuon app.",
+		Description: "Provides information about a Nuon app, looked up by ID or by name.",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Description: "The human readable name of the app.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"id": schema.StringAttribute{
 				Description: "The unique ID of the app.",
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The app description.",
+				Computed:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The display name of the app.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the app.",
+				Computed:    true,
+			},
+			"status_description": schema.StringAttribute{
+				Description: "A description of the app status.",
+				Computed:    true,
+			},
+			"sandbox": schema.SingleNestedAttribute{
+				Description: "The current sandbox config of the app, if one has been set.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"config_id": schema.StringAttribute{
+						Description: "The unique ID of the sandbox config.",
+						Computed:    true,
+					},
+					"terraform_version": schema.StringAttribute{
+						Description: "The terraform version used by the sandbox.",
+						Computed:    true,
+					},
+				},
+			},
+			"component_ids": schema.ListAttribute{
+				Description: "The IDs of the components of the app.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"install_ids": schema.ListAttribute{
+				Description: "The IDs of the installs of the app.",
+				ElementType: types.StringType,
+				Computed:    true,
 			},
 		},
 	}
@@ -53,15 +118,69 @@ func (d *AppDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
-	tflog.Trace(ctx, "fetching app by id")
-	appResp, err := d.restClient.GetApp(ctx, data.Id.ValueString())
-	if err != nil {
-		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "get app")
-		return
+	var (
+		appResp *models.AppApp
+		err     error
+	)
+	if !data.Id.IsNull() {
+		tflog.Trace(ctx, "fetching app by id")
+		appResp, err = d.restClient.GetApp(ctx, data.Id.ValueString())
+		if err != nil {
+			writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "get app")
+			return
+		}
+	} else {
+		tflog.Trace(ctx, "looking up app by name")
+		appResp, err = lookupApp(ctx, d.restClient, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to get app", err.Error())
+			return
+		}
 	}
 
 	data.Name = types.StringValue(appResp.Name)
 	data.Id = types.StringValue(appResp.ID)
+	data.Description = types.StringValue(appResp.Description)
+	data.DisplayName = types.StringValue(appResp.DisplayName)
+	data.Status = types.StringValue(appResp.Status)
+	data.StatusDescription = types.StringValue(appResp.StatusDescription)
+
+	data.Sandbox = nil
+	if appResp.SandboxConfig != nil && appResp.SandboxConfig.ID != "" {
+		data.Sandbox = &AppDataSourceSandbox{
+			ConfigID:         types.StringValue(appResp.SandboxConfig.ID),
+			TerraformVersion: types.StringValue(appResp.SandboxConfig.TerraformVersion),
+		}
+	}
+
+	compsResp, err := d.restClient.GetAppComponents(ctx, appResp.ID)
+	if err != nil {
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "get app components")
+		return
+	}
+	componentIDs := make([]string, 0, len(compsResp))
+	for _, comp := range compsResp {
+		componentIDs = append(componentIDs, comp.ID)
+	}
+
+	installsResp, err := d.restClient.GetAppInstalls(ctx, appResp.ID)
+	if err != nil {
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "get app installs")
+		return
+	}
+	installIDs := make([]string, 0, len(installsResp))
+	for _, install := range installsResp {
+		installIDs = append(installIDs, install.ID)
+	}
+
+	var diags diag.Diagnostics
+	data.ComponentIDs, diags = types.ListValueFrom(ctx, types.StringType, componentIDs)
+	resp.Diagnostics.Append(diags...)
+	data.InstallIDs, diags = types.ListValueFrom(ctx, types.StringType, installIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -14,10 +14,18 @@ func TestAppDataSource(t *testing.T) {
 			{
 				Config: providerConfig + `data "nuon_app" "my_app" {
                     id = "app123"
+                }
+
+                data "nuon_app" "by_name" {
+                    name = "my_app"
                 }`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.nuon_app.my_app", "name", "my_app"),
 					resource.TestCheckResourceAttr("data.nuon_app.my_app", "id", "app123"),
+					resource.TestCheckResourceAttrSet("data.nuon_app.my_app", "status"),
+					resource.TestCheckResourceAttrSet("data.nuon_app.my_app", "component_ids.#"),
+					resource.TestCheckResourceAttrSet("data.nuon_app.my_app", "install_ids.#"),
+					resource.TestCheckResourceAttr("data.nuon_app.by_name", "id", "app123"),
 				),
 			},
 		},
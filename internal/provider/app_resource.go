@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,6 +22,7 @@ import (
 var (
 	_ resource.Resource                = &AppResource{}
 	_ resource.ResourceWithImportState = &AppResource{}
+	_ resource.ResourceWithModifyPlan  = &AppResource{}
 )
 
 func NewAppResource() resource.Resource {
@@ -39,6 +41,10 @@ type AppResourceModel struct {
 	DisplayName     types.String `tfsdk:"display_name"`
 	SlackWebhookURL types.String `tfsdk:"slack_webhook_url"`
 	Id              types.String `tfsdk:"id"`
+
+	// provider-side settings, not stored in the api
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+	ForceDelete        types.Bool `tfsdk:"force_delete"`
 }
 
 func (r *AppResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -65,9 +71,25 @@ func (r *AppResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				Required:            false,
 			},
 			"slack_webhook_url": schema.StringAttribute{
-				MarkdownDescription: "The slack webhook url to send notifications too",
+				MarkdownDescription: "The slack webhook url to send notifications too. Once set, the url can be changed but not removed. Removing it from the config keeps the current url, which is still read back from the API.",
 				Optional:            true,
 				Required:            false,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "When true, destroying the app fails. Set to false and apply before destroying the app.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"force_delete": schema.BoolAttribute{
+				MarkdownDescription: "When true, destroying the app first deletes all of its installs, and then its components in dependency order. Otherwise the app can only be destroyed once it has no installs or components. **Warning:** this deprovisions every install of the app, including installs with `deletion_protection` enabled. Progress is only logged, and is shown when running with `TF_LOG=INFO`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -80,6 +102,37 @@ func (r *AppResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 	}
 }
 
+func (r *AppResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check on create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		var forceDelete types.Bool
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("force_delete"), &forceDelete)...)
+		if forceDelete.ValueBool() {
+			resp.Diagnostics.AddWarning(
+				"App installs will be deprovisioned",
+				"force_delete is enabled, so destroying this app first deletes all of its installs and components. Every install is deprovisioned, including installs with deletion_protection enabled.",
+			)
+		}
+		return
+	}
+
+	// the api can not remove a slack webhook url, so an unset url keeps the current one.
+	var configured, current types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("slack_webhook_url"), &configured)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("slack_webhook_url"), &current)...)
+	if configured.IsNull() && !current.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("slack_webhook_url"),
+			"Slack webhook url not cleared",
+			"The API does not support removing the slack webhook url of an app, so notifications are still sent to the current url. Set a new url to change it.",
+		)
+	}
+}
+
 func (r *AppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// get terraform model
 	var data *AppResourceModel
@@ -107,6 +160,7 @@ func (r *AppResource) Create(ctx context.Context, req resource.CreateRequest, re
 	data.Description = types.StringValue(appResp.Description)
 	data.DisplayName = types.StringValue(appResp.DisplayName)
 	data.Description = types.StringValue(appResp.Description)
+	data.SlackWebhookURL = appSlackWebhookURL(data.SlackWebhookURL, appResp)
 
 	// return populated terraform model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -172,7 +226,13 @@ func (r *AppResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	data.Id = types.StringValue(appResp.ID)
 	data.Description = types.StringValue(appResp.Description)
 	data.DisplayName = types.StringValue(appResp.DisplayName)
-	data.SlackWebhookURL = types.StringValue(appResp.NotificationsConfig.SlackWebhookURL)
+	data.SlackWebhookURL = appSlackWebhookURL(data.SlackWebhookURL, appResp)
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
+	if data.ForceDelete.IsNull() {
+		data.ForceDelete = types.BoolValue(false)
+	}
 
 	// return populated terraform model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -228,14 +288,31 @@ func (r *AppResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Unable to delete app",
+			fmt.Sprintf("App %s has deletion_protection enabled. Set deletion_protection to false and apply before destroying it.", data.Id.ValueString()),
+		)
+		return
+	}
+
+	if data.ForceDelete.ValueBool() {
+		tflog.Info(ctx, "force deleting app installs and components", map[string]interface{}{"app_id": data.Id.ValueString()})
+		if err := r.deleteAppInstalls(ctx, data.Id.ValueString()); err != nil {
+			writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "delete app installs")
+			return
+		}
+		if err := r.deleteAppComponents(ctx, data.Id.ValueString()); err != nil {
+			writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "delete app components")
+			return
+		}
+	}
+
 	tflog.Trace(ctx, "deleting app")
 
 	deleted, err := r.restClient.DeleteApp(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to delete app",
-			fmt.Sprintf("Please make sure your app_id (%s) is correct, and that the auth token has permissions for this org. ", data.Id.String()),
-		)
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "delete app")
 		return
 	}
 	if !deleted {
@@ -273,6 +350,108 @@ func (r *AppResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 }
 
+// deleteAppInstalls deletes all installs of an app, and waits for them to be deprovisioned.
+func (r *AppResource) deleteAppInstalls(ctx context.Context, appID string) error {
+	installs, err := r.restClient.GetAppInstalls(ctx, appID)
+	if err != nil {
+		return err
+	}
+
+	for _, install := range installs {
+		deleted, err := r.restClient.DeleteInstall(ctx, install.ID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return fmt.Errorf("install %s was not deleted", install.ID)
+		}
+		tflog.Info(ctx, "deleting install", map[string]interface{}{"install_id": install.ID, "name": install.Name})
+	}
+
+	for _, install := range installs {
+		if err := waitForInstallDeleted(ctx, r.restClient, install.ID); err != nil {
+			return err
+		}
+		tflog.Info(ctx, "deleted install", map[string]interface{}{"install_id": install.ID})
+	}
+
+	return nil
+}
+
+// deleteAppComponents deletes all components of an app, deleting each component only once no remaining component
+// depends on it.
+func (r *AppResource) deleteAppComponents(ctx context.Context, appID string) error {
+	comps, err := r.restClient.GetAppComponents(ctx, appID)
+	if err != nil {
+		return err
+	}
+
+	for _, comp := range componentDeleteOrder(comps) {
+		deleted, err := r.restClient.DeleteComponent(ctx, comp.ID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return fmt.Errorf("component %s was not deleted", comp.ID)
+		}
+		tflog.Info(ctx, "deleting component", map[string]interface{}{"component_id": comp.ID, "name": comp.Name})
+
+		if err := waitForComponentDeleted(ctx, r.restClient, comp.ID); err != nil {
+			return err
+		}
+		tflog.Info(ctx, "deleted component", map[string]interface{}{"component_id": comp.ID})
+	}
+
+	return nil
+}
+
+// componentDeleteOrder orders components so that each component comes before the components it depends on. Components
+// that are part of a dependency cycle are appended at the end, in their original order.
+func componentDeleteOrder(comps []*models.AppComponent) []*models.AppComponent {
+	dependents := make(map[string]int, len(comps))
+	for _, comp := range comps {
+		dependents[comp.ID] = 0
+	}
+	for _, comp := range comps {
+		for _, dep := range comp.Dependencies {
+			if _, ok := dependents[dep]; ok {
+				dependents[dep]++
+			}
+		}
+	}
+
+	ordered := make([]*models.AppComponent, 0, len(comps))
+	done := make(map[string]bool, len(comps))
+	for len(ordered) < len(comps) {
+		progressed := false
+		for _, comp := range comps {
+			if done[comp.ID] || dependents[comp.ID] > 0 {
+				continue
+			}
+
+			ordered = append(ordered, comp)
+			done[comp.ID] = true
+			progressed = true
+			for _, dep := range comp.Dependencies {
+				if _, ok := dependents[dep]; ok {
+					dependents[dep]--
+				}
+			}
+		}
+		if !progressed {
+			break
+		}
+	}
+
+	for _, comp := range comps {
+		if !done[comp.ID] {
+			ordered = append(ordered, comp)
+		}
+	}
+
+	return ordered
+}
+
 func (r *AppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 	// resource.ImportStatePassthroughID(ctx, path.Root("org_id"), req, resp)
@@ -7,15 +7,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/nuonco/nuon-go/models"
 )
 
 func testAccAppResource(app AppResourceModel) string {
+	slackWebhookURL := "null"
+	if !app.SlackWebhookURL.IsNull() {
+		slackWebhookURL = app.SlackWebhookURL.String()
+	}
+
 	return fmt.Sprintf(providerConfig+`
 resource "nuon_app" "my_app" {
     name = %s
+    slack_webhook_url = %s
 }
 `,
 		app.Name,
+		slackWebhookURL,
 	)
 }
 
@@ -25,7 +33,8 @@ func TestAppResource(t *testing.T) {
 	}
 
 	updatedApp := AppResourceModel{
-		Name: types.StringValue(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)),
+		Name:            types.StringValue(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)),
+		SlackWebhookURL: types.StringValue("https://hooks.slack.com/services/T000/B000/XXXX"),
 	}
 
 	resource.Test(t, resource.TestCase{
@@ -36,6 +45,7 @@ func TestAppResource(t *testing.T) {
 				Config: testAccAppResource(app),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("nuon_app.my_app", "name", app.Name.ValueString()),
+					resource.TestCheckNoResourceAttr("nuon_app.my_app", "slack_webhook_url"),
 				),
 			},
 			// ImportState
@@ -49,9 +59,44 @@ func TestAppResource(t *testing.T) {
 				Config: testAccAppResource(updatedApp),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("nuon_app.my_app", "name", updatedApp.Name.ValueString()),
+					resource.TestCheckResourceAttr("nuon_app.my_app", "slack_webhook_url", updatedApp.SlackWebhookURL.ValueString()),
+				),
+			},
+			// Removing the slack webhook url keeps the current url
+			{
+				Config: testAccAppResource(AppResourceModel{Name: updatedApp.Name}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nuon_app.my_app", "slack_webhook_url", updatedApp.SlackWebhookURL.ValueString()),
 				),
 			},
 			// Delete testing will happen automatically.
 		},
 	})
 }
+
+func TestComponentDeleteOrder(t *testing.T) {
+	comps := []*models.AppComponent{
+		{ID: "db"},
+		{ID: "api", Dependencies: []string{"db", "cache"}},
+		{ID: "cache"},
+		{ID: "web", Dependencies: []string{"api", "other-app-component"}},
+	}
+
+	ordered := componentDeleteOrder(comps)
+	if len(ordered) != len(comps) {
+		t.Fatalf("expected %d components, got %d", len(comps), len(ordered))
+	}
+
+	position := map[string]int{}
+	for idx, comp := range ordered {
+		position[comp.ID] = idx
+	}
+	for _, comp := range comps {
+		for _, dep := range comp.Dependencies {
+			depIdx, ok := position[dep]
+			if ok && depIdx < position[comp.ID] {
+				t.Errorf("%s is deleted before %s, which depends on it", dep, comp.ID)
+			}
+		}
+	}
+}
@@ -3,12 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/nuonco/nuon-go"
@@ -19,6 +22,48 @@ import (
 var _ resource.Resource = &AppRunnerResource{}
 var _ resource.ResourceWithImportState = &AppRunnerResource{}
 
+// appRunnerTypes are the runner types listed in the runner_type description. runner_type is validated against the
+// api enum, so runner types added to the api are accepted without changes here.
+var appRunnerTypes = []models.AppAppRunnerType{
+	models.AppAppRunnerTypeAwsDashEcs,
+	models.AppAppRunnerTypeAwsDashEks,
+	models.AppAppRunnerTypeAzureDashAks,
+	models.AppAppRunnerTypeAzureDashAcs,
+}
+
+func appRunnerTypeNames() []string {
+	names := make([]string, 0, len(appRunnerTypes))
+	for _, typ := range appRunnerTypes {
+		names = append(names, string(typ))
+	}
+	return names
+}
+
+// appRunnerTypeValidator validates a runner type against the runner type enum of the api.
+type appRunnerTypeValidator struct{}
+
+func (v appRunnerTypeValidator) Description(ctx context.Context) string {
+	return "value must be a runner type supported by the api"
+}
+
+func (v appRunnerTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v appRunnerTypeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := models.AppAppRunnerType(req.ConfigValue.ValueString()).Validate(strfmt.Default); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid runner type",
+			fmt.Sprintf("%q is not a runner type supported by the API: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
 func NewAppRunnerResource() resource.Resource {
 	return &AppRunnerResource{}
 }
@@ -59,10 +104,13 @@ func (r *AppRunnerResource) Schema(ctx context.Context, req resource.SchemaReque
 				PlanModifiers: []planmodifier.String{},
 			},
 			"runner_type": schema.StringAttribute{
-				Description:   "runner type",
+				Description:   fmt.Sprintf("runner type, one of (%s)", strings.Join(appRunnerTypeNames(), ", ")),
 				Optional:      false,
 				Required:      true,
 				PlanModifiers: []planmodifier.String{},
+				Validators: []validator.String{
+					appRunnerTypeValidator{},
+				},
 			},
 		},
 		Blocks: map[string]schema.Block{
@@ -76,18 +124,11 @@ func (r *AppRunnerResource) getConfigRequest(data *AppRunnerResourceModel) (*mod
 		EnvVars: make(map[string]string),
 	}
 
-	switch data.RunnerType.ValueString() {
-	case "aws-eks":
-		cfgReq.Type = models.NewAppAppRunnerType(models.AppAppRunnerTypeAwsDashEks)
-	case "aws-ecs":
-		cfgReq.Type = models.NewAppAppRunnerType(models.AppAppRunnerTypeAwsDashEcs)
-	case "azure-aks":
-		cfgReq.Type = models.NewAppAppRunnerType(models.AppAppRunnerTypeAzureDashAks)
-	case "azure-acs":
-		cfgReq.Type = models.NewAppAppRunnerType(models.AppAppRunnerTypeAzureDashAcs)
-	default:
-		return nil, fmt.Errorf("invalid runner-type, must be one of (aws-eks, aws-ecs, azure-aks, azure-acs)")
+	runnerType := models.AppAppRunnerType(data.RunnerType.ValueString())
+	if err := runnerType.Validate(strfmt.Default); err != nil {
+		return nil, fmt.Errorf("invalid runner-type: %w", err)
 	}
+	cfgReq.Type = runnerType.Pointer()
 
 	// configure inputs
 	for _, input := range data.EnvVar {
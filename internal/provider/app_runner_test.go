@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAppRunnerTypeValidator(t *testing.T) {
+	tests := map[string]struct {
+		value    types.String
+		expected bool
+	}{
+		"aws-ecs": {value: types.StringValue("aws-ecs")},
+		"null":    {value: types.StringNull()},
+		"unknown": {value: types.StringUnknown()},
+		"invalid": {value: types.StringValue("gcp-gke"), expected: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("runner_type"), ConfigValue: test.value}
+			resp := &validator.StringResponse{}
+
+			appRunnerTypeValidator{}.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != test.expected {
+				t.Errorf("HasError() = %t, want %t: %v", resp.Diagnostics.HasError(), test.expected, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nuonco/nuon-go/models"
+)
+
+var _ datasource.DataSource = &AppSandboxReleaseDataSource{}
+
+func NewAppSandboxReleaseDataSource() datasource.DataSource {
+	return &AppSandboxReleaseDataSource{}
+}
+
+// AppSandboxReleaseDataSource defines the data source implementation.
+type AppSandboxReleaseDataSource struct {
+	baseDataSource
+}
+
+// AppSandboxReleaseDataSourceModel describes the data source data model.
+type AppSandboxReleaseDataSourceModel struct {
+	AppID types.String `tfsdk:"app_id"`
+
+	// computed
+	ID                      types.String `tfsdk:"id"`
+	TerraformVersion        types.String `tfsdk:"terraform_version"`
+	ProvisionPolicyURL      types.String `tfsdk:"provision_policy_url"`
+	DeprovisionPolicyURL    types.String `tfsdk:"deprovision_policy_url"`
+	TrustPolicyURL          types.String `tfsdk:"trust_policy_url"`
+	OneClickRoleTemplateURL types.String `tfsdk:"one_click_role_template_url"`
+}
+
+func (d *AppSandboxReleaseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_sandbox_release"
+}
+
+func (d *AppSandboxReleaseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides the policy and template URLs of an app's current sandbox. These can be used to create the IAM role for an install before creating the install.",
+		Attributes: map[string]schema.Attribute{
+			"app_id": schema.StringAttribute{
+				Description: "The unique ID of the app.",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the current sandbox config.",
+				Computed:    true,
+			},
+			"terraform_version": schema.StringAttribute{
+				Description: "The terraform version used by the sandbox.",
+				Computed:    true,
+			},
+			"provision_policy_url": schema.StringAttribute{
+				Description: "The URL of the IAM policy needed to provision the sandbox.",
+				Computed:    true,
+			},
+			"deprovision_policy_url": schema.StringAttribute{
+				Description: "The URL of the IAM policy needed to deprovision the sandbox.",
+				Computed:    true,
+			},
+			"trust_policy_url": schema.StringAttribute{
+				Description: "The URL of the trust policy for the install IAM role.",
+				Computed:    true,
+			},
+			"one_click_role_template_url": schema.StringAttribute{
+				Description: "The URL of the CloudFormation template that creates the install IAM role.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *AppSandboxReleaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AppSandboxReleaseDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "fetching app sandbox config")
+	configResp, err := d.restClient.GetAppSandboxLatestConfig(ctx, data.AppID.ValueString())
+	if err != nil {
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "get app sandbox")
+		return
+	}
+
+	data.ID = types.StringValue(configResp.ID)
+	data.TerraformVersion = types.StringValue(configResp.TerraformVersion)
+
+	// the artifacts are not always set, in which case the urls are left empty.
+	artifacts := configResp.Artifacts
+	if artifacts == nil {
+		artifacts = &models.AppAppSandboxConfigArtifacts{}
+	}
+	data.ProvisionPolicyURL = types.StringValue(artifacts.ProvisionPolicy)
+	data.DeprovisionPolicyURL = types.StringValue(artifacts.DeprovisionPolicy)
+	data.TrustPolicyURL = types.StringValue(artifacts.TrustPolicy)
+	data.OneClickRoleTemplateURL = types.StringValue(artifacts.CloudformationStackTemplate)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
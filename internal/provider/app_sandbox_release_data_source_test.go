@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAppSandboxReleaseDataSource(t *testing.T) {
+	t.Skip()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "nuon_app_sandbox_release" "sandbox" {
+                    app_id = "app123"
+                }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.nuon_app_sandbox_release.sandbox", "id"),
+					resource.TestCheckResourceAttrSet("data.nuon_app_sandbox_release.sandbox", "trust_policy_url"),
+					resource.TestCheckResourceAttrSet("data.nuon_app_sandbox_release.sandbox", "provision_policy_url"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &AppsDataSource{}
+
+func NewAppsDataSource() datasource.DataSource {
+	return &AppsDataSource{}
+}
+
+// AppsDataSource defines the data source implementation.
+type AppsDataSource struct {
+	baseDataSource
+}
+
+// AppSummary describes a single app in the apps list.
+type AppSummary struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Status      types.String `tfsdk:"status"`
+}
+
+// AppsDataSourceModel describes the data source data model.
+type AppsDataSourceModel struct {
+	// filters
+	NamePrefix types.String `tfsdk:"name_prefix"`
+
+	// computed
+	Apps []AppSummary `tfsdk:"apps"`
+}
+
+func (d *AppsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apps"
+}
+
+func (d *AppsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a list of the Nuon apps in the org, optionally filtered by name.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Description: "Only return apps whose name starts with this prefix.",
+				Optional:    true,
+			},
+			"apps": schema.ListNestedAttribute{
+				Description: "The apps matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique ID of the app.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The human readable name of the app.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "The display name of the app.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the app.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AppsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AppsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "fetching apps")
+	appsResp, err := d.restClient.GetApps(ctx)
+	if err != nil {
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "get apps")
+		return
+	}
+
+	data.Apps = make([]AppSummary, 0, len(appsResp))
+	for _, app := range appsResp {
+		if !data.NamePrefix.IsNull() && !strings.HasPrefix(app.Name, data.NamePrefix.ValueString()) {
+			continue
+		}
+
+		data.Apps = append(data.Apps, AppSummary{
+			ID:          types.StringValue(app.ID),
+			Name:        types.StringValue(app.Name),
+			DisplayName: types.StringValue(app.DisplayName),
+			Status:      types.StringValue(app.Status),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
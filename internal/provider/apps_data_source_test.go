@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAppsDataSource(t *testing.T) {
+	appName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(providerConfig+`
+resource "nuon_app" "my_app" {
+    name = %q
+}
+
+data "nuon_apps" "filtered" {
+    name_prefix = nuon_app.my_app.name
+}
+`, appName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nuon_apps.filtered", "apps.#", "1"),
+					resource.TestCheckResourceAttr("data.nuon_apps.filtered", "apps.0.name", appName),
+					resource.TestCheckResourceAttrPair("data.nuon_apps.filtered", "apps.0.id", "nuon_app.my_app", "id"),
+				),
+			},
+		},
+	})
+}
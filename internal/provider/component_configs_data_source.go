@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ComponentConfigsDataSource{}
+
+func NewComponentConfigsDataSource() datasource.DataSource {
+	return &ComponentConfigsDataSource{}
+}
+
+// ComponentConfigsDataSource defines the data source implementation.
+type ComponentConfigsDataSource struct {
+	baseDataSource
+}
+
+// ComponentConfigsDataSourceModel describes the data source data model.
+type ComponentConfigsDataSourceModel struct {
+	ComponentID types.String      `tfsdk:"component_id"`
+	Configs     []ComponentConfig `tfsdk:"configs"`
+}
+
+func (d *ComponentConfigsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_component_configs"
+}
+
+func (d *ComponentConfigsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides the config history of a component. A new config version is created each time the component is updated.",
+		Attributes: map[string]schema.Attribute{
+			"component_id": schema.StringAttribute{
+				Description: "The unique ID of the component.",
+				Required:    true,
+			},
+			"configs": schema.ListNestedAttribute{
+				Description: "The config versions of the component, ordered from oldest to newest.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: componentConfigAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *ComponentConfigsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ComponentConfigsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "fetching component configs")
+	configsResp, err := d.restClient.GetComponentConfigs(ctx, data.ComponentID.ValueString())
+	if err != nil {
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "get component configs")
+		return
+	}
+
+	// the API does not guarantee an order, so sort by version to make diffs between reads stable.
+	sort.SliceStable(configsResp, func(i, j int) bool {
+		return configsResp[i].Version < configsResp[j].Version
+	})
+
+	data.Configs = make([]ComponentConfig, 0, len(configsResp))
+	for _, cfg := range configsResp {
+		config, err := newComponentConfig(cfg)
+		if err != nil {
+			writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "get component configs")
+			return
+		}
+		data.Configs = append(data.Configs, *config)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
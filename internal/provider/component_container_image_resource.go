@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -13,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/nuonco/nuon-go"
 	"github.com/nuonco/nuon-go/models"
 )
@@ -49,7 +47,7 @@ type ContainerImageComponentResourceModel struct {
 
 	Name         types.String `tfsdk:"name"`
 	VarName      types.String `tfsdk:"var_name"`
-	Dependencies types.List   `tfsdk:"dependencies"`
+	Dependencies types.Set    `tfsdk:"dependencies"`
 	AppID        types.String `tfsdk:"app_id"`
 
 	AwsEcr *AwsEcr `tfsdk:"aws_ecr"`
@@ -78,11 +76,7 @@ func (r *ContainerImageComponentResource) Schema(ctx context.Context, req resour
 				Optional:    false,
 				Required:    true,
 			},
-			"var_name": schema.StringAttribute{
-				Description: "The optional var name to be used when referencing this component.",
-				Optional:    true,
-				Required:    false,
-			},
+			"var_name": componentVarNameAttribute(),
 			"app_id": schema.StringAttribute{
 				Description: "The unique ID of the app this component belongs too.",
 				Optional:    false,
@@ -91,7 +85,7 @@ func (r *ContainerImageComponentResource) Schema(ctx context.Context, req resour
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"dependencies": schema.ListAttribute{
+			"dependencies": schema.SetAttribute{
 				ElementType: types.StringType,
 				Description: "Component dependencies",
 				Optional:    true,
@@ -167,7 +161,7 @@ func (r *ContainerImageComponentResource) Create(ctx context.Context, req resour
 	tflog.Trace(ctx, "got ID -- "+compResp.ID)
 	data.ID = types.StringValue(compResp.ID)
 	data.Name = types.StringValue(compResp.Name)
-	//data.VarName = types.StringValue(compResp.VarName)
+	data.VarName = componentVarName(compResp)
 
 	configRequest := &models.ServiceCreateExternalImageComponentConfigRequest{}
 	if data.AwsEcr != nil {
@@ -216,7 +210,8 @@ func (r *ContainerImageComponentResource) Read(ctx context.Context, req resource
 		return
 	}
 	data.Name = types.StringValue(compResp.Name)
-	data.VarName = types.StringValue(compResp.VarName)
+	data.VarName = componentVarName(compResp)
+	data.Dependencies = componentDependencies(ctx, data.Dependencies, compResp)
 	data.AppID = types.StringValue(compResp.AppID)
 
 	configResp, err := r.restClient.GetComponentLatestConfig(ctx, data.ID.ValueString())
@@ -265,29 +260,9 @@ func (r *ContainerImageComponentResource) Delete(ctx context.Context, req resour
 		return
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{statusActive, statusDeleteQueued, statusDeprovisioning, statusTemporarilyUnavailable},
-		Target:  []string{statusNotFound},
-		Refresh: func() (interface{}, string, error) {
-			tflog.Trace(ctx, "refreshing component status")
-			cmp, err := r.restClient.GetComponent(ctx, data.ID.ValueString())
-			if err == nil {
-				return cmp.Status, cmp.Status, nil
-			}
-			if nuon.IsNotFound(err) {
-				return "", statusNotFound, nil
-			}
-
-			logErr(ctx, err, "delete component")
-			return statusTemporarilyUnavailable, statusTemporarilyUnavailable, nil
-		},
-		Timeout:    time.Minute * 20,
-		Delay:      time.Second * 10,
-		MinTimeout: 3 * time.Second,
-	}
-	_, err = stateConf.WaitForState()
+	err = waitForComponentDeleted(ctx, r.restClient, data.ID.ValueString())
 	if err != nil {
-		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "unable to delete component")
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "delete component")
 		return
 	}
 
@@ -309,9 +284,16 @@ func (r *ContainerImageComponentResource) Update(ctx context.Context, req resour
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// only a configured var name is sent, so the api keeps resolving the default one from the component name.
+	var varName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("var_name"), &varName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	compResp, err := r.restClient.UpdateComponent(ctx, data.ID.ValueString(), &models.ServiceUpdateComponentRequest{
 		Name:         data.Name.ValueStringPointer(),
-		VarName:      data.VarName.ValueString(),
+		VarName:      varName.ValueString(),
 		Dependencies: dependencies,
 	})
 	if err != nil {
@@ -319,7 +301,7 @@ func (r *ContainerImageComponentResource) Update(ctx context.Context, req resour
 		return
 	}
 	data.Name = types.StringValue(compResp.Name)
-	//data.VarName = types.StringValue(compResp.VarName)
+	data.VarName = componentVarName(compResp)
 
 	configRequest := &models.ServiceCreateExternalImageComponentConfigRequest{}
 	if data.AwsEcr != nil {
@@ -344,5 +326,5 @@ func (r *ContainerImageComponentResource) Update(ctx context.Context, req resour
 }
 
 func (r *ContainerImageComponentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importComponentState(ctx, r.restClient, req, resp)
 }
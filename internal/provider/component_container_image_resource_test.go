@@ -62,6 +62,7 @@ func TestComponentContainerImageResource(t *testing.T) {
 				Config: testAccComponentContainerImageResource(app, component),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("nuon_container_image_component.my_component", "name", component.Name.ValueString()),
+					resource.TestCheckResourceAttrSet("nuon_container_image_component.my_component", "var_name"),
 					resource.TestCheckResourceAttr("nuon_container_image_component.my_component", "public.image_url", component.Public.ImageURL.ValueString()),
 					resource.TestCheckResourceAttr("nuon_container_image_component.my_component", "public.tag", component.Public.Tag.ValueString()),
 				),
@@ -72,6 +73,13 @@ func TestComponentContainerImageResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import State by app and component name
+			{
+				ResourceName:      "nuon_container_image_component.my_component",
+				ImportState:       true,
+				ImportStateId:     app.Name.ValueString() + "/" + component.Name.ValueString(),
+				ImportStateVerify: true,
+			},
 			// Update and Read
 			{
 				Config: testAccComponentContainerImageResource(app, updatedComponent),
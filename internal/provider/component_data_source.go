@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nuonco/nuon-go"
+	"github.com/nuonco/nuon-go/models"
+)
+
+var _ datasource.DataSource = &ComponentDataSource{}
+
+func NewComponentDataSource() datasource.DataSource {
+	return &ComponentDataSource{}
+}
+
+// ComponentDataSource defines the data source implementation.
+type ComponentDataSource struct {
+	baseDataSource
+}
+
+// ComponentConfig describes a single config version of a component.
+type ComponentConfig struct {
+	ID        types.String `tfsdk:"id"`
+	Version   types.Int64  `tfsdk:"version"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Config    types.String `tfsdk:"config"`
+}
+
+// ComponentDataSourceModel describes the data source data model.
+type ComponentDataSourceModel struct {
+	// inputs
+	ID    types.String `tfsdk:"id"`
+	AppID types.String `tfsdk:"app_id"`
+	Name  types.String `tfsdk:"name"`
+
+	// computed
+	Type              types.String     `tfsdk:"type"`
+	VarName           types.String     `tfsdk:"var_name"`
+	Dependencies      types.Set        `tfsdk:"dependencies"`
+	Status            types.String     `tfsdk:"status"`
+	StatusDescription types.String     `tfsdk:"status_description"`
+	ConfigVersions    types.Int64      `tfsdk:"config_versions"`
+	LatestConfig      *ComponentConfig `tfsdk:"latest_config"`
+}
+
+func (d *ComponentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_component"
+}
+
+func (d *ComponentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides information about a component of a Nuon app, looked up by ID or by app ID and name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the component.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Description: "The unique ID of the app the component belongs to. Required when looking up a component by name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The human-readable name of the component.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("app_id")),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The component type (e.g. helm_chart or terraform_module).",
+				Computed:    true,
+			},
+			"var_name": schema.StringAttribute{
+				Description: "The var name used when referencing this component.",
+				Computed:    true,
+			},
+			"dependencies": schema.SetAttribute{
+				ElementType: types.StringType,
+				Description: "The IDs of the components this component depends on.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the component.",
+				Computed:    true,
+			},
+			"status_description": schema.StringAttribute{
+				Description: "A description of the component status.",
+				Computed:    true,
+			},
+			"config_versions": schema.Int64Attribute{
+				Description: "The number of config versions the component has.",
+				Computed:    true,
+			},
+			"latest_config": schema.SingleNestedAttribute{
+				Description: "The latest config of the component.",
+				Computed:    true,
+				Attributes:  componentConfigAttributes(),
+			},
+		},
+	}
+}
+
+func componentConfigAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique ID of the config.",
+			Computed:    true,
+		},
+		"version": schema.Int64Attribute{
+			Description: "The config version, incremented each time the component is updated.",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "When the config was created.",
+			Computed:    true,
+		},
+		"config": schema.StringAttribute{
+			Description: "The type-specific config, as a JSON encoded string. Use jsondecode to access its fields.",
+			Computed:    true,
+		},
+	}
+}
+
+func newComponentConfig(cfg *models.AppComponentConfigConnection) (*ComponentConfig, error) {
+	var typeCfg interface{}
+	switch {
+	case cfg.DockerBuild != nil:
+		typeCfg = cfg.DockerBuild
+	case cfg.ExternalImage != nil:
+		typeCfg = cfg.ExternalImage
+	case cfg.Helm != nil:
+		typeCfg = cfg.Helm
+	case cfg.Job != nil:
+		typeCfg = cfg.Job
+	case cfg.TerraformModule != nil:
+		typeCfg = cfg.TerraformModule
+	}
+
+	config := types.StringNull()
+	if typeCfg != nil {
+		byts, err := json.Marshal(typeCfg)
+		if err != nil {
+			return nil, err
+		}
+		config = types.StringValue(string(byts))
+	}
+
+	return &ComponentConfig{
+		ID:        types.StringValue(cfg.ID),
+		Version:   types.Int64Value(cfg.Version),
+		CreatedAt: types.StringValue(cfg.CreatedAt),
+		Config:    config,
+	}, nil
+}
+
+func (d *ComponentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ComponentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		compResp *models.AppComponent
+		err      error
+	)
+	if !data.ID.IsNull() {
+		tflog.Trace(ctx, "fetching component by id")
+		compResp, err = d.restClient.GetComponent(ctx, data.ID.ValueString())
+	} else {
+		tflog.Trace(ctx, "fetching component by name")
+		compResp, err = d.restClient.GetAppComponent(ctx, data.AppID.ValueString(), data.Name.ValueString())
+	}
+	if err != nil {
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "get component")
+		return
+	}
+
+	data.ID = types.StringValue(compResp.ID)
+	data.AppID = types.StringValue(compResp.AppID)
+	data.Name = types.StringValue(compResp.Name)
+	data.Type = types.StringValue(string(compResp.Type))
+	data.VarName = componentVarName(compResp)
+	data.Dependencies = stringSliceToSet(ctx, compResp.Dependencies)
+	data.Status = types.StringValue(compResp.Status)
+	data.StatusDescription = types.StringValue(compResp.StatusDescription)
+	data.ConfigVersions = types.Int64Value(compResp.ConfigVersions)
+
+	// a component that failed to be configured has no configs, which is not an error here.
+	configResp, err := d.restClient.GetComponentLatestConfig(ctx, compResp.ID)
+	if err != nil && !nuon.IsNotFound(err) {
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "get component config")
+		return
+	}
+	if err == nil {
+		data.LatestConfig, err = newComponentConfig(configResp)
+		if err != nil {
+			writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "get component config")
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
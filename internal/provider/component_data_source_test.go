@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccComponentDataSource(appName, componentName string) string {
+	return fmt.Sprintf(providerConfig+`
+resource "nuon_app" "my_app" {
+    name = %q
+}
+
+resource "nuon_container_image_component" "my_component" {
+    app_id = nuon_app.my_app.id
+    name = %q
+
+    public = {
+	image_url = "kennethreitz/httpbin"
+	tag = "latest"
+    }
+}
+
+data "nuon_component" "by_id" {
+    id = nuon_container_image_component.my_component.id
+}
+
+data "nuon_component" "by_name" {
+    app_id = nuon_app.my_app.id
+    name = nuon_container_image_component.my_component.name
+}
+
+data "nuon_component_configs" "history" {
+    component_id = nuon_container_image_component.my_component.id
+}
+
+data "nuon_components" "all" {
+    app_id = nuon_app.my_app.id
+
+    depends_on = [nuon_container_image_component.my_component]
+}
+`, appName, componentName)
+}
+
+func TestComponentDataSource(t *testing.T) {
+	appName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	componentName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentDataSource(appName, componentName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nuon_component.by_id", "name", componentName),
+					resource.TestCheckResourceAttrPair("data.nuon_component.by_id", "app_id", "nuon_app.my_app", "id"),
+					resource.TestCheckResourceAttrSet("data.nuon_component.by_id", "var_name"),
+					resource.TestCheckResourceAttrSet("data.nuon_component.by_id", "latest_config.id"),
+					resource.TestCheckResourceAttrPair("data.nuon_component.by_name", "id", "nuon_container_image_component.my_component", "id"),
+					resource.TestCheckResourceAttr("data.nuon_components.all", "components.#", "1"),
+					resource.TestCheckResourceAttr("data.nuon_components.all", "components.0.name", componentName),
+					resource.TestCheckResourceAttr("data.nuon_component_configs.history", "configs.#", "1"),
+					resource.TestCheckResourceAttrPair("data.nuon_component_configs.history", "configs.0.id", "data.nuon_component.by_id", "latest_config.id"),
+				),
+			},
+		},
+	})
+}
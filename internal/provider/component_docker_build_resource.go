@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/nuonco/nuon-go"
 	"github.com/nuonco/nuon-go/models"
 )
@@ -37,7 +35,7 @@ type DockerBuildComponentResourceModel struct {
 	ID           types.String `tfsdk:"id"`
 	Name         types.String `tfsdk:"name"`
 	VarName      types.String `tfsdk:"var_name"`
-	Dependencies types.List   `tfsdk:"dependencies"`
+	Dependencies types.Set    `tfsdk:"dependencies"`
 	AppID        types.String `tfsdk:"app_id"`
 
 	EnvVar []EnvVar `tfsdk:"env_var"`
@@ -68,12 +66,8 @@ func (r *DockerBuildComponentResource) Schema(ctx context.Context, req resource.
 				Optional:    false,
 				Required:    true,
 			},
-			"var_name": schema.StringAttribute{
-				Description: "The optional var name to be used when referencing this component.",
-				Optional:    true,
-				Required:    false,
-			},
-			"dependencies": schema.ListAttribute{
+			"var_name": componentVarNameAttribute(),
+			"dependencies": schema.SetAttribute{
 				ElementType: types.StringType,
 				Description: "Component dependencies",
 				Optional:    true,
@@ -128,7 +122,7 @@ func (r *DockerBuildComponentResource) Create(ctx context.Context, req resource.
 	tflog.Trace(ctx, "got ID -- "+compResp.ID)
 	data.ID = types.StringValue(compResp.ID)
 	data.Name = types.StringValue(compResp.Name)
-	//data.VarName = types.StringValue(compResp.VarName)
+	data.VarName = componentVarName(compResp)
 
 	configRequest := &models.ServiceCreateDockerBuildComponentConfigRequest{
 		BuildArgs:  []string{},
@@ -187,7 +181,8 @@ func (r *DockerBuildComponentResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 	data.Name = types.StringValue(compResp.Name)
-	data.VarName = types.StringValue(compResp.VarName)
+	data.VarName = componentVarName(compResp)
+	data.Dependencies = componentDependencies(ctx, data.Dependencies, compResp)
 	data.AppID = types.StringValue(compResp.AppID)
 
 	configResp, err := r.restClient.GetComponentLatestConfig(ctx, data.ID.ValueString())
@@ -247,29 +242,9 @@ func (r *DockerBuildComponentResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{statusActive, statusDeleteQueued, statusDeprovisioning, statusTemporarilyUnavailable},
-		Target:  []string{statusNotFound},
-		Refresh: func() (interface{}, string, error) {
-			tflog.Trace(ctx, "refreshing component status")
-			cmp, err := r.restClient.GetComponent(ctx, data.ID.ValueString())
-			if err == nil {
-				return cmp.Status, cmp.Status, nil
-			}
-			if nuon.IsNotFound(err) {
-				return "", statusNotFound, nil
-			}
-
-			logErr(ctx, err, "delete component")
-			return statusTemporarilyUnavailable, statusTemporarilyUnavailable, nil
-		},
-		Timeout:    time.Minute * 20,
-		Delay:      time.Second * 10,
-		MinTimeout: 3 * time.Second,
-	}
-	_, err = stateConf.WaitForState()
+	err = waitForComponentDeleted(ctx, r.restClient, data.ID.ValueString())
 	if err != nil {
-		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "unable to delete component")
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "delete component")
 		return
 	}
 	tflog.Trace(ctx, "successfully deleted component")
@@ -290,9 +265,16 @@ func (r *DockerBuildComponentResource) Update(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// only a configured var name is sent, so the api keeps resolving the default one from the component name.
+	var varName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("var_name"), &varName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	compResp, err := r.restClient.UpdateComponent(ctx, data.ID.ValueString(), &models.ServiceUpdateComponentRequest{
 		Name:         data.Name.ValueStringPointer(),
-		VarName:      data.VarName.ValueString(),
+		VarName:      varName.ValueString(),
 		Dependencies: dependencies,
 	})
 	if err != nil {
@@ -300,7 +282,7 @@ func (r *DockerBuildComponentResource) Update(ctx context.Context, req resource.
 		return
 	}
 	data.Name = types.StringValue(compResp.Name)
-	//data.VarName = types.StringValue(compResp.VarName)
+	data.VarName = componentVarName(compResp)
 
 	configRequest := &models.ServiceCreateDockerBuildComponentConfigRequest{
 		BuildArgs:  []string{},
@@ -337,5 +319,5 @@ func (r *DockerBuildComponentResource) Update(ctx context.Context, req resource.
 }
 
 func (r *DockerBuildComponentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importComponentState(ctx, r.restClient, req, resp)
 }
@@ -87,6 +87,7 @@ func TestComponentHelmChartResource(t *testing.T) {
 				Config: testAccComponentHelmChartResource(app, component),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("nuon_helm_chart_component.my_component", "name", component.Name.ValueString()),
+					resource.TestCheckResourceAttrSet("nuon_helm_chart_component.my_component", "var_name"),
 					resource.TestCheckResourceAttr("nuon_helm_chart_component.my_component", "chart_name", component.ChartName.ValueString()),
 					resource.TestCheckResourceAttr("nuon_helm_chart_component.my_component", "public_repo.repo", component.PublicRepo.Repo.ValueString()),
 					resource.TestCheckResourceAttr("nuon_helm_chart_component.my_component", "public_repo.branch", component.PublicRepo.Branch.ValueString()),
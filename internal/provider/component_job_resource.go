@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -13,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/nuonco/nuon-go"
 	"github.com/nuonco/nuon-go/models"
 )
@@ -37,7 +35,7 @@ type JobComponentResourceModel struct {
 
 	Name         types.String `tfsdk:"name"`
 	VarName      types.String `tfsdk:"var_name"`
-	Dependencies types.List   `tfsdk:"dependencies"`
+	Dependencies types.Set    `tfsdk:"dependencies"`
 	AppID        types.String `tfsdk:"app_id"`
 	ImageURL     types.String `tfsdk:"image_url"`
 	Tag          types.String `tfsdk:"tag"`
@@ -66,11 +64,7 @@ func (r *JobComponentResource) Schema(ctx context.Context, req resource.SchemaRe
 				Optional:    false,
 				Required:    true,
 			},
-			"var_name": schema.StringAttribute{
-				Description: "The optional var name to be used when referencing this component.",
-				Optional:    true,
-				Required:    false,
-			},
+			"var_name": componentVarNameAttribute(),
 			"app_id": schema.StringAttribute{
 				Description: "The unique ID of the app this component belongs too.",
 				Optional:    false,
@@ -79,7 +73,7 @@ func (r *JobComponentResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"dependencies": schema.ListAttribute{
+			"dependencies": schema.SetAttribute{
 				ElementType: types.StringType,
 				Description: "Component dependencies",
 				Optional:    true,
@@ -135,7 +129,7 @@ func (r *JobComponentResource) Create(ctx context.Context, req resource.CreateRe
 	}
 	tflog.Trace(ctx, "got ID -- "+compResp.ID)
 	data.ID = types.StringValue(compResp.ID)
-	//data.VarName = types.StringValue(compResp.VarName)
+	data.VarName = componentVarName(compResp)
 
 	configRequest := &models.ServiceCreateJobComponentConfigRequest{
 		ImageURL: data.ImageURL.ValueStringPointer(),
@@ -178,7 +172,8 @@ func (r *JobComponentResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 	data.Name = types.StringValue(compResp.Name)
-	data.VarName = types.StringValue(compResp.VarName)
+	data.VarName = componentVarName(compResp)
+	data.Dependencies = componentDependencies(ctx, data.Dependencies, compResp)
 	data.AppID = types.StringValue(compResp.AppID)
 
 	configResp, err := r.restClient.GetComponentLatestConfig(ctx, data.ID.ValueString())
@@ -218,29 +213,9 @@ func (r *JobComponentResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{statusActive, statusDeleteQueued, statusDeprovisioning, statusTemporarilyUnavailable},
-		Target:  []string{statusNotFound},
-		Refresh: func() (interface{}, string, error) {
-			tflog.Trace(ctx, "refreshing component status")
-			cmp, err := r.restClient.GetComponent(ctx, data.ID.ValueString())
-			if err == nil {
-				return cmp.Status, cmp.Status, nil
-			}
-			if nuon.IsNotFound(err) {
-				return "", statusNotFound, nil
-			}
-
-			logErr(ctx, err, "delete component")
-			return statusTemporarilyUnavailable, statusTemporarilyUnavailable, nil
-		},
-		Timeout:    time.Minute * 20,
-		Delay:      time.Second * 10,
-		MinTimeout: 3 * time.Second,
-	}
-	_, err = stateConf.WaitForState()
+	err = waitForComponentDeleted(ctx, r.restClient, data.ID.ValueString())
 	if err != nil {
-		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "unable to delete component")
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "delete component")
 		return
 	}
 
@@ -262,9 +237,16 @@ func (r *JobComponentResource) Update(ctx context.Context, req resource.UpdateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// only a configured var name is sent, so the api keeps resolving the default one from the component name.
+	var varName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("var_name"), &varName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	compResp, err := r.restClient.UpdateComponent(ctx, data.ID.ValueString(), &models.ServiceUpdateComponentRequest{
 		Name:         data.Name.ValueStringPointer(),
-		VarName:      data.VarName.ValueString(),
+		VarName:      varName.ValueString(),
 		Dependencies: dependencies,
 	})
 	if err != nil {
@@ -273,7 +255,7 @@ func (r *JobComponentResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	data.Name = types.StringValue(compResp.Name)
-	//data.VarName = types.StringValue(compResp.VarName)
+	data.VarName = componentVarName(compResp)
 
 	configRequest := &models.ServiceCreateJobComponentConfigRequest{
 		ImageURL: data.ImageURL.ValueStringPointer(),
@@ -293,5 +275,5 @@ func (r *JobComponentResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *JobComponentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importComponentState(ctx, r.restClient, req, resp)
 }
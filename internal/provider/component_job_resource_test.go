@@ -64,6 +64,9 @@ func TestComponentJobResource(t *testing.T) {
 		EnvVar:   NewEnvVarSliceFromMap(map[string]string{"PGPASSWORD": "password"}),
 	}
 
+	// no var name is configured, so renaming the job should resolve a new one
+	var varName string
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -72,6 +75,13 @@ func TestComponentJobResource(t *testing.T) {
 				Config: testAccComponentJobResource(app, job),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("nuon_job_component.my_job", "name", job.Name.ValueString()),
+					resource.TestCheckResourceAttrWith("nuon_job_component.my_job", "var_name", func(value string) error {
+						if value == "" {
+							return fmt.Errorf("expected var_name to be resolved")
+						}
+						varName = value
+						return nil
+					}),
 					resource.TestCheckResourceAttr("nuon_job_component.my_job", "image_url", job.ImageURL.ValueString()),
 					resource.TestCheckResourceAttr("nuon_job_component.my_job", "tag", job.Tag.ValueString()),
 					resource.TestCheckTypeSetElemAttr("nuon_job_component.my_job", "cmd.*", listToStringSlice(job.Cmd)[0]),
@@ -93,6 +103,12 @@ func TestComponentJobResource(t *testing.T) {
 				Config: testAccComponentJobResource(app, updatedJob),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("nuon_job_component.my_job", "name", updatedJob.Name.ValueString()),
+					resource.TestCheckResourceAttrWith("nuon_job_component.my_job", "var_name", func(value string) error {
+						if value == varName {
+							return fmt.Errorf("expected var_name to be resolved from the new name, got %s", value)
+						}
+						return nil
+					}),
 					resource.TestCheckResourceAttr("nuon_job_component.my_job", "image_url", updatedJob.ImageURL.ValueString()),
 					resource.TestCheckResourceAttr("nuon_job_component.my_job", "tag", updatedJob.Tag.ValueString()),
 					resource.TestCheckTypeSetElemAttr("nuon_job_component.my_job", "cmd.*", listToStringSlice(updatedJob.Cmd)[0]),
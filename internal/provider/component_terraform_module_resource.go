@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/nuonco/nuon-go"
 	"github.com/nuonco/nuon-go/models"
 )
@@ -43,7 +41,7 @@ type TerraformModuleComponentResourceModel struct {
 
 	Name             types.String        `tfsdk:"name"`
 	VarName          types.String        `tfsdk:"var_name"`
-	Dependencies     types.List          `tfsdk:"dependencies"`
+	Dependencies     types.Set           `tfsdk:"dependencies"`
 	AppID            types.String        `tfsdk:"app_id"`
 	TerraformVersion types.String        `tfsdk:"terraform_version"`
 	PublicRepo       *PublicRepo         `tfsdk:"public_repo"`
@@ -72,11 +70,7 @@ func (r *TerraformModuleComponentResource) Schema(ctx context.Context, req resou
 				Optional:    false,
 				Required:    true,
 			},
-			"var_name": schema.StringAttribute{
-				Description: "The optional var name to be used when referencing this component.",
-				Optional:    true,
-				Required:    false,
-			},
+			"var_name": componentVarNameAttribute(),
 			"app_id": schema.StringAttribute{
 				Description: "The unique ID of the app this component belongs too.",
 				Optional:    false,
@@ -85,7 +79,7 @@ func (r *TerraformModuleComponentResource) Schema(ctx context.Context, req resou
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"dependencies": schema.ListAttribute{
+			"dependencies": schema.SetAttribute{
 				ElementType: types.StringType,
 				Description: "Component dependencies",
 				Optional:    true,
@@ -148,7 +142,7 @@ func (r *TerraformModuleComponentResource) Create(ctx context.Context, req resou
 	tflog.Trace(ctx, "got ID -- "+compResp.ID)
 	data.ID = types.StringValue(compResp.ID)
 	data.Name = types.StringValue(compResp.Name)
-	//data.VarName = types.StringValue(compResp.VarName)
+	data.VarName = componentVarName(compResp)
 
 	configRequest := &models.ServiceCreateTerraformModuleComponentConfigRequest{
 		ConnectedGithubVcsConfig: nil,
@@ -227,7 +221,8 @@ func (r *TerraformModuleComponentResource) Read(ctx context.Context, req resourc
 
 	// populate terraform model with data from api
 	data.Name = types.StringValue(compResp.Name)
-	data.VarName = types.StringValue(compResp.VarName)
+	data.VarName = componentVarName(compResp)
+	data.Dependencies = componentDependencies(ctx, data.Dependencies, compResp)
 	data.AppID = types.StringValue(compResp.AppID)
 	data.TerraformVersion = types.StringValue(terraformConfig.Version)
 	if terraformConfig.ConnectedGithubVcsConfig != nil {
@@ -288,29 +283,9 @@ func (r *TerraformModuleComponentResource) Delete(ctx context.Context, req resou
 		return
 	}
 
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{statusActive, statusDeleteQueued, statusDeprovisioning, statusTemporarilyUnavailable},
-		Target:  []string{statusNotFound},
-		Refresh: func() (interface{}, string, error) {
-			tflog.Trace(ctx, "refreshing component status")
-			cmp, err := r.restClient.GetComponent(ctx, data.ID.ValueString())
-			if err == nil {
-				return cmp.Status, cmp.Status, nil
-			}
-			if nuon.IsNotFound(err) {
-				return "", statusNotFound, nil
-			}
-
-			logErr(ctx, err, "delete component")
-			return statusTemporarilyUnavailable, statusTemporarilyUnavailable, nil
-		},
-		Timeout:    time.Minute * 20,
-		Delay:      time.Second * 10,
-		MinTimeout: 3 * time.Second,
-	}
-	_, err = stateConf.WaitForState()
+	err = waitForComponentDeleted(ctx, r.restClient, data.ID.ValueString())
 	if err != nil {
-		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "unable to delete component")
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "delete component")
 		return
 	}
 }
@@ -330,9 +305,16 @@ func (r *TerraformModuleComponentResource) Update(ctx context.Context, req resou
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// only a configured var name is sent, so the api keeps resolving the default one from the component name.
+	var varName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("var_name"), &varName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	compResp, err := r.restClient.UpdateComponent(ctx, data.ID.ValueString(), &models.ServiceUpdateComponentRequest{
 		Name:         data.Name.ValueStringPointer(),
-		VarName:      data.VarName.ValueString(),
+		VarName:      varName.ValueString(),
 		Dependencies: dependencies,
 	})
 	if err != nil {
@@ -340,7 +322,7 @@ func (r *TerraformModuleComponentResource) Update(ctx context.Context, req resou
 		return
 	}
 	data.Name = types.StringValue(compResp.Name)
-	//data.VarName = types.StringValue(compResp.VarName)
+	data.VarName = componentVarName(compResp)
 
 	configRequest := &models.ServiceCreateTerraformModuleComponentConfigRequest{
 		ConnectedGithubVcsConfig: nil,
@@ -378,5 +360,5 @@ func (r *TerraformModuleComponentResource) Update(ctx context.Context, req resou
 }
 
 func (r *TerraformModuleComponentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importComponentState(ctx, r.restClient, req, resp)
 }
@@ -83,6 +83,7 @@ func TestComponentTerraformModuleResource(t *testing.T) {
 				Config: testAccComponentTerraformModuleResource(app, component),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("nuon_terraform_module_component.my_component", "name", component.Name.ValueString()),
+					resource.TestCheckResourceAttrSet("nuon_terraform_module_component.my_component", "var_name"),
 					resource.TestCheckResourceAttr("nuon_terraform_module_component.my_component", "public_repo.repo", component.PublicRepo.Repo.ValueString()),
 					resource.TestCheckResourceAttr("nuon_terraform_module_component.my_component", "public_repo.branch", component.PublicRepo.Branch.ValueString()),
 					resource.TestCheckResourceAttr("nuon_terraform_module_component.my_component", "public_repo.directory", component.PublicRepo.Directory.ValueString()),
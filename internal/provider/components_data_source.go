@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ComponentsDataSource{}
+
+func NewComponentsDataSource() datasource.DataSource {
+	return &ComponentsDataSource{}
+}
+
+// ComponentsDataSource defines the data source implementation.
+type ComponentsDataSource struct {
+	baseDataSource
+}
+
+// ComponentSummary describes a single component in the components list.
+type ComponentSummary struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	VarName      types.String `tfsdk:"var_name"`
+	Dependencies types.Set    `tfsdk:"dependencies"`
+	Status       types.String `tfsdk:"status"`
+}
+
+// ComponentsDataSourceModel describes the data source data model.
+type ComponentsDataSourceModel struct {
+	AppID      types.String       `tfsdk:"app_id"`
+	Components []ComponentSummary `tfsdk:"components"`
+}
+
+func (d *ComponentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_components"
+}
+
+func (d *ComponentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides information about all components of a Nuon app.",
+		Attributes: map[string]schema.Attribute{
+			"app_id": schema.StringAttribute{
+				Description: "The unique ID of the app.",
+				Required:    true,
+			},
+			"components": schema.ListNestedAttribute{
+				Description: "The components of the app.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique ID of the component.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The human-readable name of the component.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The component type (e.g. helm_chart or terraform_module).",
+							Computed:    true,
+						},
+						"var_name": schema.StringAttribute{
+							Description: "The var name used when referencing this component.",
+							Computed:    true,
+						},
+						"dependencies": schema.SetAttribute{
+							ElementType: types.StringType,
+							Description: "The IDs of the components this component depends on.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the component.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ComponentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ComponentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "fetching components for app")
+	compsResp, err := d.restClient.GetAppComponents(ctx, data.AppID.ValueString())
+	if err != nil {
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "get components")
+		return
+	}
+
+	data.Components = make([]ComponentSummary, 0, len(compsResp))
+	for _, comp := range compsResp {
+		data.Components = append(data.Components, ComponentSummary{
+			ID:           types.StringValue(comp.ID),
+			Name:         types.StringValue(comp.Name),
+			Type:         types.StringValue(string(comp.Type)),
+			VarName:      componentVarName(comp),
+			Dependencies: stringSliceToSet(ctx, comp.Dependencies),
+			Status:       types.StringValue(comp.Status),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
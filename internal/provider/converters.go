@@ -31,12 +31,55 @@ func convertSandboxRelease(sandboxRelease models.AppSandboxRelease) basetypes.Ob
 	return obj
 }
 
+// componentVarName returns the var name a component is referenced by, falling back to the var name resolved by the
+// api when none was set.
+func componentVarName(comp *models.AppComponent) types.String {
+	if comp.VarName != "" {
+		return types.StringValue(comp.VarName)
+	}
+	return types.StringValue(comp.ResolvedVarName)
+}
+
+// componentDependencies returns the dependencies of a component as a Terraform Set. When the api returns no
+// dependencies and none were set, either as null or as an empty set, the current value is kept so that it does not
+// produce a diff.
+func componentDependencies(ctx context.Context, current types.Set, comp *models.AppComponent) types.Set {
+	if len(comp.Dependencies) == 0 {
+		if current.IsNull() || len(current.Elements()) == 0 {
+			return current
+		}
+		return stringSliceToSet(ctx, []string{})
+	}
+	return stringSliceToSet(ctx, comp.Dependencies)
+}
+
+// appSlackWebhookURL returns the slack webhook url of an app. When the api does not return the notifications config,
+// the current value is kept.
+func appSlackWebhookURL(current types.String, app *models.AppApp) types.String {
+	if app.NotificationsConfig == nil {
+		if current.IsUnknown() {
+			return types.StringNull()
+		}
+		return current
+	}
+	if app.NotificationsConfig.SlackWebhookURL == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(app.NotificationsConfig.SlackWebhookURL)
+}
+
 // convert from a []string{} to a Terraform List
 func stringSliceToList(ctx context.Context, stringSlice []string) types.List {
 	list, _ := types.ListValueFrom(ctx, types.StringType, stringSlice)
 	return list
 }
 
+// convert from a []string{} to a Terraform Set
+func stringSliceToSet(ctx context.Context, stringSlice []string) types.Set {
+	set, _ := types.SetValueFrom(ctx, types.StringType, stringSlice)
+	return set
+}
+
 // convert a Terraform List to a []string{}
 func listToStringSlice(list types.List) []string {
 	stringSlice := []string{}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nuonco/nuon-go/models"
+)
+
+func TestComponentDependencies(t *testing.T) {
+	ctx := context.Background()
+	empty := stringSliceToSet(ctx, []string{})
+	deps := stringSliceToSet(ctx, []string{"cmp1"})
+
+	tests := map[string]struct {
+		current  types.Set
+		api      []string
+		expected types.Set
+	}{
+		"unset and none returned":       {current: types.SetNull(types.StringType), expected: types.SetNull(types.StringType)},
+		"empty and none returned":       {current: empty, expected: empty},
+		"set and none returned":         {current: deps, expected: empty},
+		"unset and dependencies exist":  {current: types.SetNull(types.StringType), api: []string{"cmp1"}, expected: deps},
+		"set and dependencies returned": {current: deps, api: []string{"cmp1"}, expected: deps},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := componentDependencies(ctx, test.current, &models.AppComponent{Dependencies: test.api})
+			if !got.Equal(test.expected) {
+				t.Errorf("componentDependencies() = %s, want %s", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestAppSlackWebhookURL(t *testing.T) {
+	url := "https://hooks.slack.com/services/T000/B000/XXXX"
+
+	tests := map[string]struct {
+		current       types.String
+		notifications *models.AppNotificationsConfig
+		expected      types.String
+	}{
+		"imported with a url":       {current: types.StringNull(), notifications: &models.AppNotificationsConfig{SlackWebhookURL: url}, expected: types.StringValue(url)},
+		"no url":                    {current: types.StringNull(), notifications: &models.AppNotificationsConfig{}, expected: types.StringNull()},
+		"changed outside terraform": {current: types.StringValue("https://example.com"), notifications: &models.AppNotificationsConfig{SlackWebhookURL: url}, expected: types.StringValue(url)},
+		"no notifications config":   {current: types.StringValue(url), expected: types.StringValue(url)},
+		"created without a url":     {current: types.StringUnknown(), expected: types.StringNull()},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := appSlackWebhookURL(test.current, &models.AppApp{NotificationsConfig: test.notifications})
+			if !got.Equal(test.expected) {
+				t.Errorf("appSlackWebhookURL() = %s, want %s", got, test.expected)
+			}
+		})
+	}
+}
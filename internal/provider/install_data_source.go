@@ -3,9 +3,14 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nuonco/nuon-go"
 )
 
 var _ datasource.DataSource = &InstallDataSource{}
@@ -19,10 +24,29 @@ type InstallDataSource struct {
 	baseDataSource
 }
 
+// InstallDataSourceAzureAccount describes the azure account of an install. The service principal
+// password is never returned.
+type InstallDataSourceAzureAccount struct {
+	Location              types.String `tfsdk:"location"`
+	SubscriptionID        types.String `tfsdk:"subscription_id"`
+	SubscriptionTenantID  types.String `tfsdk:"subscription_tenant_id"`
+	ServicePrincipalAppID types.String `tfsdk:"service_principal_app_id"`
+}
+
 // InstallDataSourceModel describes the data source data model.
 type InstallDataSourceModel struct {
-	Name types.String `tfsdk:"name"`
-	Id   types.String `tfsdk:"id"`
+	// inputs
+	Id    types.String `tfsdk:"id"`
+	AppID types.String `tfsdk:"app_id"`
+	Name  types.String `tfsdk:"name"`
+
+	// computed
+	SandboxStatus     types.String                   `tfsdk:"sandbox_status"`
+	Status            types.String                   `tfsdk:"status"`
+	StatusDescription types.String                   `tfsdk:"status_description"`
+	AWSAccount        *AWSAccount                    `tfsdk:"aws"`
+	AzureAccount      *InstallDataSourceAzureAccount `tfsdk:"azure"`
+	Inputs            types.Map                      `tfsdk:"inputs"`
 }
 
 func (d *InstallDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,10 +61,76 @@ func (d *InstallDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 			"name": schema.StringAttribute{
 				Description: "The human-readable name of the install.",
 				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("app_id")),
+				},
 			},
 			"id": schema.StringAttribute{
 				Description: "The unique ID of the install.",
 				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Description: "The unique ID of the app the install belongs to. Required when looking up an install by name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"sandbox_status": schema.StringAttribute{
+				Description: "The status of the install sandbox.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the install.",
+				Computed:    true,
+			},
+			"status_description": schema.StringAttribute{
+				Description: "A description of the install status.",
+				Computed:    true,
+			},
+			"aws": schema.SingleNestedAttribute{
+				Description: "The AWS account of the install, if it is an AWS install.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The AWS region the install is in.",
+						Computed:    true,
+					},
+					"iam_role_arn": schema.StringAttribute{
+						Description: "The IAM role ARN used to access the install account.",
+						Computed:    true,
+					},
+				},
+			},
+			"azure": schema.SingleNestedAttribute{
+				Description: "The Azure account of the install, if it is an Azure install.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"location": schema.StringAttribute{
+						Description: "The Azure location the install is in.",
+						Computed:    true,
+					},
+					"subscription_id": schema.StringAttribute{
+						Description: "The subscription id.",
+						Computed:    true,
+					},
+					"subscription_tenant_id": schema.StringAttribute{
+						Description: "The subscription tenant id.",
+						Computed:    true,
+					},
+					"service_principal_app_id": schema.StringAttribute{
+						Description: "The service principal app id.",
+						Computed:    true,
+					},
+				},
+			},
+			"inputs": schema.MapAttribute{
+				Description: "The current inputs of the install. Sensitive input values are redacted.",
+				ElementType: types.StringType,
+				Computed:    true,
 			},
 		},
 	}
@@ -53,12 +143,62 @@ func (d *InstallDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	installResp, err := d.restClient.GetInstall(ctx, data.Id.ValueString())
+	installID := data.Id.ValueString()
+	if data.Id.IsNull() {
+		tflog.Trace(ctx, "looking up install by name")
+		install, err := lookupInstall(ctx, d.restClient, data.AppID.ValueString(), data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to get install", err.Error())
+			return
+		}
+		installID = install.ID
+	}
+
+	installResp, err := d.restClient.GetInstall(ctx, installID)
 	if err != nil {
 		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "get install")
 		return
 	}
+	data.Id = types.StringValue(installResp.ID)
+	data.AppID = types.StringValue(installResp.AppID)
 	data.Name = types.StringValue(installResp.Name)
+	data.SandboxStatus = types.StringValue(installResp.SandboxStatus)
+	data.Status = types.StringValue(installResp.Status)
+	data.StatusDescription = types.StringValue(installResp.StatusDescription)
+
+	data.AWSAccount = nil
+	if installResp.AwsAccount != nil {
+		data.AWSAccount = &AWSAccount{
+			Region:     types.StringValue(installResp.AwsAccount.Region),
+			IAMRoleARN: types.StringValue(installResp.AwsAccount.IamRoleArn),
+		}
+	}
+	data.AzureAccount = nil
+	if installResp.AzureAccount != nil {
+		data.AzureAccount = &InstallDataSourceAzureAccount{
+			Location:              types.StringValue(installResp.AzureAccount.Location),
+			SubscriptionID:        types.StringValue(installResp.AzureAccount.SubscriptionID),
+			SubscriptionTenantID:  types.StringValue(installResp.AzureAccount.SubscriptionTenantID),
+			ServicePrincipalAppID: types.StringValue(installResp.AzureAccount.ServicePrincipalAppID),
+		}
+	}
+
+	// an install without any inputs has no current inputs, which is not an error here.
+	inputs := map[string]string{}
+	inputsResp, err := d.restClient.GetInstallCurrentInputs(ctx, installResp.ID)
+	if err != nil && !nuon.IsNotFound(err) {
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "get install inputs")
+		return
+	}
+	if err == nil && inputsResp.RedactedValues != nil {
+		inputs = inputsResp.RedactedValues
+	}
+	inputsMap, diags := types.MapValueFrom(ctx, types.StringType, inputs)
+	data.Inputs = inputsMap
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
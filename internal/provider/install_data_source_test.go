@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestInstallDataSource(t *testing.T) {
+	t.Skip()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "nuon_install" "by_id" {
+                    id = "inl123"
+                }
+
+                data "nuon_install" "by_name" {
+                    app_id = "app123"
+                    name = "my_install"
+                }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nuon_install.by_id", "name", "my_install"),
+					resource.TestCheckResourceAttr("data.nuon_install.by_id", "app_id", "app123"),
+					resource.TestCheckResourceAttrSet("data.nuon_install.by_id", "status"),
+					resource.TestCheckResourceAttrSet("data.nuon_install.by_id", "sandbox_status"),
+					resource.TestCheckResourceAttr("data.nuon_install.by_name", "id", "inl123"),
+				),
+			},
+		},
+	})
+}
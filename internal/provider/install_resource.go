@@ -3,8 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,6 +25,7 @@ import (
 var (
 	_ resource.Resource                = &InstallResource{}
 	_ resource.ResourceWithImportState = &InstallResource{}
+	_ resource.ResourceWithModifyPlan  = &InstallResource{}
 )
 
 func NewInstallResource() resource.Resource {
@@ -30,6 +35,9 @@ func NewInstallResource() resource.Resource {
 // InstallResource defines the resource implementation.
 type InstallResource struct {
 	baseResource
+
+	// deletionProtectionDefault is used for new and imported installs that do not set deletion_protection.
+	deletionProtectionDefault bool
 }
 
 type InstallInput struct {
@@ -59,6 +67,9 @@ type InstallResourceModel struct {
 	AzureAccount []AzureAccount `tfsdk:"azure"`
 	Inputs       []InstallInput `tfsdk:"input"`
 
+	// provider-side settings, not stored in the api
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+
 	// computed
 	ID types.String `tfsdk:"id"`
 }
@@ -84,6 +95,11 @@ func (r *InstallResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "When true, destroying or replacing the install fails, since it deprovisions the install's infrastructure. Set to false and apply before destroying the install. Defaults to the provider's install_deletion_protection.",
+				Optional:    true,
+				Computed:    true,
+			},
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The unique ID of the install",
@@ -130,12 +146,12 @@ func (r *InstallResource) Schema(ctx context.Context, req resource.SchemaRequest
 							},
 						},
 						"service_principal_password": schema.StringAttribute{
-							Description: "The service principal password.",
+							Description: "The service principal password. The password returned by the API is never written to state, so only changes to the configured value are planned.",
 							Optional:    false,
 							Required:    true,
 							Sensitive:   true,
 							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
+								servicePrincipalPasswordRequiresReplace(),
 							},
 						},
 					},
@@ -183,6 +199,118 @@ func (r *InstallResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+func (r *InstallResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.baseResource.Configure(ctx, req, resp)
+	if providerData, ok := req.ProviderData.(*ProviderData); ok {
+		r.deletionProtectionDefault = providerData.InstallDeletionProtection
+	}
+}
+
+func (r *InstallResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var deletionProtection types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// new installs use the provider default, existing installs keep their current value
+	if req.State.Raw.IsNull() {
+		if deletionProtection.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deletion_protection"), r.deletionProtectionDefault)...)
+		}
+		return
+	}
+	if deletionProtection.IsUnknown() {
+		var current types.Bool
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &current)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deletion_protection"), current)...)
+	}
+
+	// changing the cloud account replaces the install, which deprovisions and reprovisions all of its infrastructure.
+	for _, cloud := range []string{installCloudAWS, installCloudAzure} {
+		var planned, current types.Set
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(cloud), &planned)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(cloud), &current)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if attrs := accountReplaceAttributes(planned, current); len(attrs) > 0 {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root(cloud),
+				"Install will be replaced",
+				fmt.Sprintf("Changing %s in the %s block replaces the install. The install's infrastructure will be fully deprovisioned and then provisioned again.", strings.Join(attrs, ", "), cloud),
+			)
+		}
+	}
+}
+
+// accountReplaceAttributes returns the names of the aws or azure block attributes whose planned change replaces the
+// install. Every attribute in those blocks requires a replace, and like the framework each planned element is compared
+// with the state element at the same index.
+func accountReplaceAttributes(planned, current types.Set) []string {
+	if planned.IsUnknown() {
+		return nil
+	}
+
+	currentElems := current.Elements()
+	replaced := map[string]bool{}
+	for idx, elem := range planned.Elements() {
+		plannedObj, ok := elem.(types.Object)
+		if !ok {
+			continue
+		}
+
+		var currentAttrs map[string]attr.Value
+		if idx < len(currentElems) {
+			if currentObj, ok := currentElems[idx].(types.Object); ok {
+				currentAttrs = currentObj.Attributes()
+			}
+		}
+
+		for name, value := range plannedObj.Attributes() {
+			prior, ok := currentAttrs[name]
+			if !ok {
+				prior = types.StringNull()
+			}
+			if value.Equal(prior) {
+				continue
+			}
+
+			// see servicePrincipalPasswordRequiresReplace
+			if name == "service_principal_password" && prior.IsNull() {
+				continue
+			}
+			replaced[name] = true
+		}
+	}
+
+	attrs := make([]string, 0, len(replaced))
+	for name := range replaced {
+		attrs = append(attrs, name)
+	}
+	sort.Strings(attrs)
+	return attrs
+}
+
+// servicePrincipalPasswordRequiresReplace replaces the install when the service principal password changes.
+// Imported installs have no password in state, since the api's copy is never stored, so setting it from config for
+// the first time is planned as an update.
+func servicePrincipalPasswordRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"Changing the service principal password replaces the install, unless no password is in state yet.",
+		"Changing the service principal password replaces the install, unless no password is in state yet.",
+	)
+}
+
 func (r *InstallResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *InstallResourceModel
 
@@ -280,6 +408,10 @@ func (r *InstallResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 	data.Name = types.StringValue(installResp.Name)
 	data.AppID = types.StringValue(installResp.AppID)
+	// imported installs, and installs in state from before deletion_protection existed, use the provider default.
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(r.deletionProtectionDefault)
+	}
 
 	if installResp.AwsAccount != nil {
 		data.AWSAccount = []AWSAccount{
@@ -290,13 +422,19 @@ func (r *InstallResource) Read(ctx context.Context, req resource.ReadRequest, re
 		}
 	}
 	if installResp.AzureAccount != nil {
+		// the password returned by the api is never written to state, only the configured one is kept.
+		password := types.StringNull()
+		if len(data.AzureAccount) == 1 {
+			password = data.AzureAccount[0].ServicePrincipalPassword
+		}
+
 		data.AzureAccount = []AzureAccount{
 			{
 				Location:                 types.StringValue(installResp.AzureAccount.Location),
 				SubscriptionID:           types.StringValue(installResp.AzureAccount.SubscriptionID),
 				SubscriptionTenantID:     types.StringValue(installResp.AzureAccount.SubscriptionTenantID),
 				ServicePrincipalAppID:    types.StringValue(installResp.AzureAccount.ServicePrincipalAppID),
-				ServicePrincipalPassword: types.StringValue(installResp.AzureAccount.ServicePrincipalPassword),
+				ServicePrincipalPassword: password,
 			},
 		}
 	}
@@ -331,15 +469,25 @@ func (r *InstallResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	installResp, err := r.restClient.UpdateInstall(ctx, data.ID.ValueString(), &models.ServiceUpdateInstallRequest{
-		Name: data.Name.ValueString(),
-	})
-	if err != nil {
-		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "update install")
+	var current *InstallResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &current)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	data.ID = types.StringValue(installResp.ID)
-	data.Name = types.StringValue(installResp.Name)
+
+	// provider-side settings such as deletion_protection are only stored in state, so the api is only called for
+	// the fields it owns.
+	if !data.Name.Equal(current.Name) {
+		installResp, err := r.restClient.UpdateInstall(ctx, data.ID.ValueString(), &models.ServiceUpdateInstallRequest{
+			Name: data.Name.ValueString(),
+		})
+		if err != nil {
+			writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "update install")
+			return
+		}
+		data.ID = types.StringValue(installResp.ID)
+		data.Name = types.StringValue(installResp.Name)
+	}
 
 	// TODO: The SDK doesn't return these values.
 	// These can't be updated anyway, so it's not a blocker,
@@ -348,20 +496,28 @@ func (r *InstallResource) Update(ctx context.Context, req resource.UpdateRequest
 	// data.IAMRoleARN = types.StringValue(installResp.AwsAccount.IamRoleArn)
 	// data.Region = types.StringValue(installResp.AwsAccount.Region)
 
-	updateReq := &models.ServiceCreateInstallInputsRequest{
-		Inputs: make(map[string]string, 0),
-	}
-	for _, input := range data.Inputs {
-		updateReq.Inputs[input.Name.ValueString()] = input.Value.ValueString()
+	inputs := installInputsMap(data.Inputs)
+	if !maps.Equal(inputs, installInputsMap(current.Inputs)) {
+		_, err := r.restClient.CreateInstallInputs(ctx, data.ID.ValueString(), &models.ServiceCreateInstallInputsRequest{
+			Inputs: inputs,
+		})
+		if err != nil {
+			writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "update install")
+			return
+		}
 	}
 
-	_, err = r.restClient.CreateInstallInputs(ctx, data.ID.ValueString(), updateReq)
-	if err != nil {
-		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "update install")
-		return
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// installInputsMap returns the install inputs keyed by name.
+func installInputsMap(inputs []InstallInput) map[string]string {
+	inputsMap := make(map[string]string, len(inputs))
+	for _, input := range inputs {
+		inputsMap[input.Name.ValueString()] = input.Value.ValueString()
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return inputsMap
 }
 
 func (r *InstallResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -371,6 +527,14 @@ func (r *InstallResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Unable to delete install",
+			fmt.Sprintf("Install %s has deletion_protection enabled. Set deletion_protection to false and apply before destroying it.", data.ID.ValueString()),
+		)
+		return
+	}
+
 	deleted, err := r.restClient.DeleteInstall(ctx, data.ID.ValueString())
 	if err != nil {
 		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "delete install")
@@ -384,29 +548,7 @@ func (r *InstallResource) Delete(ctx context.Context, req resource.DeleteRequest
 	data.ID = types.StringValue(data.ID.ValueString())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{statusActive, statusDeleteQueued, statusDeprovisioning, statusTemporarilyUnavailable},
-		Target:  []string{statusNotFound},
-		Refresh: func() (interface{}, string, error) {
-			tflog.Trace(ctx, "refreshing install status")
-			install, err := r.restClient.GetInstall(ctx, data.ID.ValueString())
-			if err == nil {
-				logErr(ctx, err, "delete install")
-				return install.SandboxStatus, install.SandboxStatus, nil
-			}
-
-			if nuon.IsNotFound(err) {
-				return "", statusNotFound, nil
-			}
-
-			logErr(ctx, err, "delete install")
-			return statusTemporarilyUnavailable, statusTemporarilyUnavailable, nil
-		},
-		Timeout:    time.Minute * 45,
-		Delay:      time.Second * 10,
-		MinTimeout: 3 * time.Second,
-	}
-	_, err = stateConf.WaitForStateContext(ctx)
+	err = waitForInstallDeleted(ctx, r.restClient, data.ID.ValueString())
 	if err != nil {
 		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "delete install")
 		return
@@ -414,5 +556,5 @@ func (r *InstallResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *InstallResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importInstallState(ctx, r.restClient, req, resp)
 }
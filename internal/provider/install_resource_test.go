@@ -1,10 +1,16 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -18,14 +24,18 @@ resource "nuon_app" "my_app" {
 resource "nuon_install" "my_install" {
     app_id = nuon_app.my_app.id
     name = %s
-    region = %s
-    iam_role_arn = %s
+    deletion_protection = false
+
+    aws {
+	region = %s
+	iam_role_arn = %s
+    }
 }
 `,
 		app.Name,
 		install.Name,
-		install.Region,
-		install.IAMRoleARN,
+		install.AWSAccount[0].Region,
+		install.AWSAccount[0].IAMRoleARN,
 	)
 }
 
@@ -34,17 +44,20 @@ func TestInstallResource(t *testing.T) {
 		Name: types.StringValue(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)),
 	}
 	install := InstallResourceModel{
-		AppID:      app.Id,
-		Name:       types.StringValue(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)),
-		Region:     types.StringValue("us-west-2"),
-		IAMRoleARN: types.StringValue(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)),
+		AppID: app.Id,
+		Name:  types.StringValue(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)),
+		AWSAccount: []AWSAccount{
+			{
+				Region:     types.StringValue("us-west-2"),
+				IAMRoleARN: types.StringValue(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)),
+			},
+		},
 	}
 
 	updatedInstall := InstallResourceModel{
 		AppID:      app.Id,
 		Name:       types.StringValue(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)),
-		Region:     types.StringValue("us-west-2"),
-		IAMRoleARN: types.StringValue(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)),
+		AWSAccount: install.AWSAccount,
 	}
 
 	resource.Test(t, resource.TestCase{
@@ -55,8 +68,11 @@ func TestInstallResource(t *testing.T) {
 				Config: testAccInstallResource(app, install),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("nuon_install.my_install", "name", install.Name.ValueString()),
-					resource.TestCheckResourceAttr("nuon_install.my_install", "region", install.Region.ValueString()),
-					resource.TestCheckResourceAttr("nuon_install.my_install", "iam_role_arn", install.IAMRoleARN.ValueString()),
+					resource.TestCheckResourceAttr("nuon_install.my_install", "deletion_protection", "false"),
+					resource.TestCheckTypeSetElemNestedAttrs("nuon_install.my_install", "aws.*", map[string]string{
+						"region":       install.AWSAccount[0].Region.ValueString(),
+						"iam_role_arn": install.AWSAccount[0].IAMRoleARN.ValueString(),
+					}),
 				),
 			},
 			// Import State
@@ -70,11 +86,100 @@ func TestInstallResource(t *testing.T) {
 				Config: testAccInstallResource(app, updatedInstall),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("nuon_install.my_install", "name", updatedInstall.Name.ValueString()),
-					resource.TestCheckResourceAttr("nuon_install.my_install", "region", updatedInstall.Region.ValueString()),
-					resource.TestCheckResourceAttr("nuon_install.my_install", "iam_role_arn", updatedInstall.IAMRoleARN.ValueString()),
+					resource.TestCheckTypeSetElemNestedAttrs("nuon_install.my_install", "aws.*", map[string]string{
+						"region":       updatedInstall.AWSAccount[0].Region.ValueString(),
+						"iam_role_arn": updatedInstall.AWSAccount[0].IAMRoleARN.ValueString(),
+					}),
 				),
 			},
 			// Delete testing will happen automatically.
 		},
 	})
 }
+
+func TestServicePrincipalPasswordRequiresReplace(t *testing.T) {
+	raw := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+
+	tests := map[string]struct {
+		state    types.String
+		plan     types.String
+		expected bool
+	}{
+		"imported install sets password": {state: types.StringNull(), plan: types.StringValue("secret"), expected: false},
+		"password unchanged":             {state: types.StringValue("secret"), plan: types.StringValue("secret"), expected: false},
+		"password changed":               {state: types.StringValue("secret"), plan: types.StringValue("rotated"), expected: true},
+		"password unknown":               {state: types.StringValue("secret"), plan: types.StringUnknown(), expected: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				State:       tfsdk.State{Raw: raw},
+				Plan:        tfsdk.Plan{Raw: raw},
+				StateValue:  test.state,
+				PlanValue:   test.plan,
+				ConfigValue: test.plan,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: test.plan}
+
+			servicePrincipalPasswordRequiresReplace().PlanModifyString(context.Background(), req, resp)
+			if resp.RequiresReplace != test.expected {
+				t.Errorf("RequiresReplace = %t, want %t", resp.RequiresReplace, test.expected)
+			}
+		})
+	}
+}
+
+func TestAccountReplaceAttributes(t *testing.T) {
+	ctx := context.Background()
+	azureType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"location":                   types.StringType,
+		"subscription_id":            types.StringType,
+		"subscription_tenant_id":     types.StringType,
+		"service_principal_app_id":   types.StringType,
+		"service_principal_password": types.StringType,
+	}}
+	azureSet := func(accounts ...AzureAccount) types.Set {
+		set, diags := types.SetValueFrom(ctx, azureType, accounts)
+		if diags.HasError() {
+			t.Fatalf("unable to build azure set: %v", diags)
+		}
+		return set
+	}
+	account := AzureAccount{
+		Location:                 types.StringValue("eastus"),
+		SubscriptionID:           types.StringValue("subscription"),
+		SubscriptionTenantID:     types.StringValue("tenant"),
+		ServicePrincipalAppID:    types.StringValue("app"),
+		ServicePrincipalPassword: types.StringValue("secret"),
+	}
+	imported := account
+	imported.ServicePrincipalPassword = types.StringNull()
+	rotated := account
+	rotated.ServicePrincipalPassword = types.StringValue("rotated")
+	moved := rotated
+	moved.Location = types.StringValue("westus")
+
+	tests := map[string]struct {
+		planned  types.Set
+		current  types.Set
+		expected []string
+	}{
+		"unchanged":                {planned: azureSet(account), current: azureSet(account), expected: []string{}},
+		"imported sets password":   {planned: azureSet(account), current: azureSet(imported), expected: []string{}},
+		"password rotated":         {planned: azureSet(rotated), current: azureSet(account), expected: []string{"service_principal_password"}},
+		"location and password":    {planned: azureSet(moved), current: azureSet(account), expected: []string{"location", "service_principal_password"}},
+		"block added":              {planned: azureSet(imported), current: types.SetNull(azureType), expected: []string{"location", "service_principal_app_id", "subscription_id", "subscription_tenant_id"}},
+		"block removed":            {planned: types.SetNull(azureType), current: azureSet(account), expected: []string{}},
+		"unknown planned accounts": {planned: types.SetUnknown(azureType), current: azureSet(account), expected: nil},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := accountReplaceAttributes(test.planned, test.current)
+			if !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("accountReplaceAttributes() = %v, want %v", got, test.expected)
+			}
+		})
+	}
+}
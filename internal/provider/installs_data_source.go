@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nuonco/nuon-go/models"
+)
+
+const (
+	installCloudAWS   string = "aws"
+	installCloudAzure string = "azure"
+)
+
+var _ datasource.DataSource = &InstallsDataSource{}
+
+func NewInstallsDataSource() datasource.DataSource {
+	return &InstallsDataSource{}
+}
+
+// InstallsDataSource defines the data source implementation.
+type InstallsDataSource struct {
+	baseDataSource
+}
+
+// InstallSummary describes a single install in the installs list.
+type InstallSummary struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	AppID  types.String `tfsdk:"app_id"`
+	Cloud  types.String `tfsdk:"cloud"`
+	Region types.String `tfsdk:"region"`
+	Status types.String `tfsdk:"status"`
+}
+
+// InstallsDataSourceModel describes the data source data model.
+type InstallsDataSourceModel struct {
+	// filters
+	AppID      types.String `tfsdk:"app_id"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Cloud      types.String `tfsdk:"cloud"`
+	Status     types.String `tfsdk:"status"`
+
+	// computed
+	Installs []InstallSummary `tfsdk:"installs"`
+}
+
+func (d *InstallsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_installs"
+}
+
+func (d *InstallsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides a list of Nuon installs, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"app_id": schema.StringAttribute{
+				Description: "Only return installs of this app. If not set, installs of all apps in the org are returned.",
+				Optional:    true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Only return installs whose name starts with this prefix.",
+				Optional:    true,
+			},
+			"cloud": schema.StringAttribute{
+				Description: "Only return installs in this cloud. One of aws or azure.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(installCloudAWS, installCloudAzure),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Only return installs with this status.",
+				Optional:    true,
+			},
+			"installs": schema.ListNestedAttribute{
+				Description: "The installs matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique ID of the install.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The human-readable name of the install.",
+							Computed:    true,
+						},
+						"app_id": schema.StringAttribute{
+							Description: "The unique ID of the app the install belongs to.",
+							Computed:    true,
+						},
+						"cloud": schema.StringAttribute{
+							Description: "The cloud the install is in, aws or azure.",
+							Computed:    true,
+						},
+						"region": schema.StringAttribute{
+							Description: "The AWS region or Azure location of the install.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the install.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func installCloudRegion(install *models.AppInstall) (string, string) {
+	switch {
+	case install.AwsAccount != nil:
+		return installCloudAWS, install.AwsAccount.Region
+	case install.AzureAccount != nil:
+		return installCloudAzure, install.AzureAccount.Location
+	default:
+		return "", ""
+	}
+}
+
+func (d *InstallsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstallsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		installsResp []*models.AppInstall
+		err          error
+	)
+	if !data.AppID.IsNull() {
+		tflog.Trace(ctx, "fetching installs for app")
+		installsResp, err = d.restClient.GetAppInstalls(ctx, data.AppID.ValueString())
+	} else {
+		tflog.Trace(ctx, "fetching all installs")
+		installsResp, err = d.restClient.GetAllInstalls(ctx)
+	}
+	if err != nil {
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "get installs")
+		return
+	}
+
+	data.Installs = make([]InstallSummary, 0, len(installsResp))
+	for _, install := range installsResp {
+		cloud, region := installCloudRegion(install)
+		if !data.NamePrefix.IsNull() && !strings.HasPrefix(install.Name, data.NamePrefix.ValueString()) {
+			continue
+		}
+		if !data.Cloud.IsNull() && cloud != data.Cloud.ValueString() {
+			continue
+		}
+		if !data.Status.IsNull() && install.Status != data.Status.ValueString() {
+			continue
+		}
+
+		data.Installs = append(data.Installs, InstallSummary{
+			ID:     types.StringValue(install.ID),
+			Name:   types.StringValue(install.Name),
+			AppID:  types.StringValue(install.AppID),
+			Cloud:  types.StringValue(cloud),
+			Region: types.StringValue(region),
+			Status: types.StringValue(install.Status),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestInstallsDataSource(t *testing.T) {
+	t.Skip()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "nuon_installs" "aws" {
+                    app_id = "app123"
+                    cloud = "aws"
+                    name_prefix = "my_"
+                }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nuon_installs.aws", "installs.#", "1"),
+					resource.TestCheckResourceAttr("data.nuon_installs.aws", "installs.0.name", "my_install"),
+					resource.TestCheckResourceAttr("data.nuon_installs.aws", "installs.0.cloud", "aws"),
+				),
+			},
+		},
+	})
+}
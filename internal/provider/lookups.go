@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/nuonco/nuon-go"
+	"github.com/nuonco/nuon-go/models"
+)
+
+// splitCompositeID splits an import ID of the form "<app>/<name>". It returns false if the ID is not
+// composite, in which case it should be treated as an opaque ID.
+func splitCompositeID(id string) (string, string, bool) {
+	app, name, ok := strings.Cut(id, "/")
+	if !ok || app == "" || name == "" {
+		return "", "", false
+	}
+
+	return app, name, true
+}
+
+// lookupApp finds an app by either its ID or its name.
+func lookupApp(ctx context.Context, client nuon.Client, nameOrID string) (*models.AppApp, error) {
+	apps, err := client.GetApps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, app := range apps {
+		if app.ID == nameOrID || app.Name == nameOrID {
+			return app, nil
+		}
+	}
+
+	return nil, fmt.Errorf("app %q not found", nameOrID)
+}
+
+// lookupInstall finds an install of an app by either its ID or its name.
+func lookupInstall(ctx context.Context, client nuon.Client, appID, nameOrID string) (*models.AppInstall, error) {
+	installs, err := client.GetAppInstalls(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, install := range installs {
+		if install.ID == nameOrID || install.Name == nameOrID {
+			return install, nil
+		}
+	}
+
+	return nil, fmt.Errorf("install %q not found", nameOrID)
+}
+
+// importComponentState imports a component by its ID, or by "<app name or ID>/<component name>".
+func importComponentState(ctx context.Context, client nuon.Client, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	appNameOrID, compName, ok := splitCompositeID(req.ID)
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	app, err := lookupApp(ctx, client, appNameOrID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to import component", err.Error())
+		return
+	}
+
+	comp, err := client.GetAppComponent(ctx, app.ID, compName)
+	if err != nil {
+		writeDiagnosticsErr(ctx, &resp.Diagnostics, err, "import component")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), comp.ID)...)
+}
+
+// importInstallState imports an install by its ID, or by "<app name or ID>/<install name>".
+func importInstallState(ctx context.Context, client nuon.Client, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	appNameOrID, installName, ok := splitCompositeID(req.ID)
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	app, err := lookupApp(ctx, client, appNameOrID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to import install", err.Error())
+		return
+	}
+
+	install, err := lookupInstall(ctx, client, app.ID, installName)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to import install", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), install.ID)...)
+}
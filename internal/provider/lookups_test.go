@@ -0,0 +1,27 @@
+package provider
+
+import "testing"
+
+func TestSplitCompositeID(t *testing.T) {
+	tests := map[string]struct {
+		id   string
+		app  string
+		name string
+		ok   bool
+	}{
+		"opaque id":    {id: "cmp123"},
+		"composite id": {id: "my_app/ingress", app: "my_app", name: "ingress", ok: true},
+		"missing app":  {id: "/ingress"},
+		"missing name": {id: "my_app/"},
+		"nested slash": {id: "my_app/a/b", app: "my_app", name: "a/b", ok: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			app, compName, ok := splitCompositeID(test.id)
+			if app != test.app || compName != test.name || ok != test.ok {
+				t.Errorf("splitCompositeID(%q) = (%q, %q, %v), want (%q, %q, %v)", test.id, app, compName, ok, test.app, test.name, test.ok)
+			}
+		})
+	}
+}
@@ -29,13 +29,17 @@ type Provider struct {
 
 // ProviderModel describes the provider data model.
 type ProviderModel struct {
-	APIAuthToken types.String `tfsdk:"api_token"`
-	OrgID        types.String `tfsdk:"org_id"`
+	APIAuthToken              types.String `tfsdk:"api_token"`
+	OrgID                     types.String `tfsdk:"org_id"`
+	InstallDeletionProtection types.Bool   `tfsdk:"install_deletion_protection"`
 }
 
 type ProviderData struct {
 	OrgID      string
 	RestClient nuon.Client
+
+	// InstallDeletionProtection is the default deletion_protection of new and imported installs.
+	InstallDeletionProtection bool
 }
 
 func (p *Provider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -55,6 +59,10 @@ func (p *Provider) Schema(ctx context.Context, req provider.SchemaRequest, resp
 				Description: "Your Nuon organization ID.",
 				Optional:    true,
 			},
+			"install_deletion_protection": schema.BoolAttribute{
+				Description: "When true, new and imported installs, and installs already in state without deletion_protection, have it enabled unless it is set on the install. Defaults to false.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -98,7 +106,8 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		RestClient: restClient,
 	}
 	resp.ResourceData = &ProviderData{
-		RestClient: restClient,
+		RestClient:                restClient,
+		InstallDeletionProtection: data.InstallDeletionProtection.ValueBool(),
 	}
 }
 
@@ -124,8 +133,14 @@ func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
 func (p *Provider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAppDataSource,
+		NewAppsDataSource,
+		NewAppSandboxReleaseDataSource,
+		NewComponentDataSource,
+		NewComponentConfigsDataSource,
+		NewComponentsDataSource,
 		NewConnectedRepoDataSource,
 		NewInstallDataSource,
+		NewInstallsDataSource,
 	}
 }
 
@@ -1,7 +1,11 @@
 package provider
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -58,3 +62,43 @@ func connectedRepoAttribute() schema.SingleNestedAttribute {
 		},
 	}
 }
+
+func componentVarNameAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The var name to be used when referencing this component. If not set, the var name resolved by the API from the component name is used. The API can not clear a var name once it is set, so removing it keeps the current var name.",
+		Optional:    true,
+		Required:    false,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			componentVarNameUseStateForUnknown{},
+		},
+	}
+}
+
+// componentVarNameUseStateForUnknown keeps an unconfigured var name from state, unless the component is renamed, since
+// the api resolves the default var name from the component name.
+type componentVarNameUseStateForUnknown struct{}
+
+func (m componentVarNameUseStateForUnknown) Description(ctx context.Context) string {
+	return "Keeps the current var name unless the component is renamed."
+}
+
+func (m componentVarNameUseStateForUnknown) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m componentVarNameUseStateForUnknown) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// nothing to keep on create, or when the var name is configured
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var planned, current types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &current)...)
+	if resp.Diagnostics.HasError() || !planned.Equal(current) {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
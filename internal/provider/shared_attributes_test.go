@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestComponentVarNameUseStateForUnknown(t *testing.T) {
+	ctx := context.Background()
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":     schema.StringAttribute{Required: true},
+			"var_name": componentVarNameAttribute(),
+		},
+	}
+	objType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":     tftypes.String,
+		"var_name": tftypes.String,
+	}}
+	raw := func(name string, varName tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objType, map[string]tftypes.Value{
+			"name":     tftypes.NewValue(tftypes.String, name),
+			"var_name": varName,
+		})
+	}
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	tests := map[string]struct {
+		plannedName string
+		config      types.String
+		plan        types.String
+		expected    types.String
+	}{
+		"not configured":          {plannedName: "api", config: types.StringNull(), plan: types.StringUnknown(), expected: types.StringValue("api")},
+		"renamed, not configured": {plannedName: "web", config: types.StringNull(), plan: types.StringUnknown(), expected: types.StringUnknown()},
+		"configured":              {plannedName: "web", config: types.StringValue("app"), plan: types.StringValue("app"), expected: types.StringValue("app")},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				State:       tfsdk.State{Schema: testSchema, Raw: raw("api", tftypes.NewValue(tftypes.String, "api"))},
+				Plan:        tfsdk.Plan{Schema: testSchema, Raw: raw(test.plannedName, unknown)},
+				StateValue:  types.StringValue("api"),
+				PlanValue:   test.plan,
+				ConfigValue: test.config,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: test.plan}
+
+			componentVarNameUseStateForUnknown{}.PlanModifyString(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(test.expected) {
+				t.Errorf("PlanValue = %s, want %s", resp.PlanValue, test.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/nuonco/nuon-go"
+)
+
+// waitForInstallDeleted waits for an install to be deprovisioned and removed, after it has been deleted.
+func waitForInstallDeleted(ctx context.Context, client nuon.Client, installID string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{statusActive, statusDeleteQueued, statusDeprovisioning, statusTemporarilyUnavailable},
+		Target:  []string{statusNotFound},
+		Refresh: func() (interface{}, string, error) {
+			tflog.Trace(ctx, "refreshing install status")
+			install, err := client.GetInstall(ctx, installID)
+			if err == nil {
+				return install.SandboxStatus, install.SandboxStatus, nil
+			}
+			if nuon.IsNotFound(err) {
+				return "", statusNotFound, nil
+			}
+
+			logErr(ctx, err, "delete install")
+			return statusTemporarilyUnavailable, statusTemporarilyUnavailable, nil
+		},
+		Timeout:    time.Minute * 45,
+		Delay:      time.Second * 10,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// waitForComponentDeleted waits for a component to be removed, after it has been deleted.
+func waitForComponentDeleted(ctx context.Context, client nuon.Client, componentID string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{statusActive, statusDeleteQueued, statusDeprovisioning, statusTemporarilyUnavailable},
+		Target:  []string{statusNotFound},
+		Refresh: func() (interface{}, string, error) {
+			tflog.Trace(ctx, "refreshing component status")
+			cmp, err := client.GetComponent(ctx, componentID)
+			if err == nil {
+				return cmp.Status, cmp.Status, nil
+			}
+			if nuon.IsNotFound(err) {
+				return "", statusNotFound, nil
+			}
+
+			logErr(ctx, err, "delete component")
+			return statusTemporarilyUnavailable, statusTemporarilyUnavailable, nil
+		},
+		Timeout:    time.Minute * 20,
+		Delay:      time.Second * 10,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}